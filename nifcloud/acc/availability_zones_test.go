@@ -0,0 +1,37 @@
+package acc
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceAvailabilityZones_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_availability_zones.available"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZonesDataSource(t, "testdata/data_availability_zones.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "names.*", "east-21"),
+					resource.TestCheckResourceAttr(datasourceName, "zones.0.state", "available"),
+					resource.TestCheckResourceAttr(datasourceName, "zones.0.region", "jp-east-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAvailabilityZonesDataSource(t *testing.T, fileName string) string {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
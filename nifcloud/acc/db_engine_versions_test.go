@@ -0,0 +1,38 @@
+package acc
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDBEngineVersions_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_db_engine_versions.mysql80"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBEngineVersionsDataSource(t, "testdata/data_db_engine_versions.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "versions.0.engine", "MySQL"),
+					resource.TestMatchResourceAttr(datasourceName, "latest_version", regexp.MustCompile(`^8\.0`)),
+					resource.TestCheckResourceAttr(datasourceName, "latest_parameter_group_family", "mysql8.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBEngineVersionsDataSource(t *testing.T, fileName string) string {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
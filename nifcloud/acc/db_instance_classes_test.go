@@ -0,0 +1,35 @@
+package acc
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDBInstanceClasses_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_db_instance_classes.mysql"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBInstanceClassesDataSource(t, "testdata/data_db_instance_classes.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "instance_classes.*", "db.large"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBInstanceClassesDataSource(t *testing.T, fileName string) string {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
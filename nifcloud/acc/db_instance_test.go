@@ -130,6 +130,36 @@ func TestAcc_DBInstance(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceDBInstance_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_db_instance.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccDBInstanceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBInstanceDataSource(t, "testdata/data_db_instance.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "engine", "MySQL"),
+					resource.TestCheckResourceAttr(datasourceName, "engine_version", "5.7.15"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_class", "db.large"),
+					resource.TestCheckResourceAttr(datasourceName, "port", "3306"),
+					resource.TestCheckResourceAttr(datasourceName, "multi_az", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "backup_window", "00:00-08:00"),
+					resource.TestCheckResourceAttr(datasourceName, "maintenance_window", "sun:23:00-sun:23:30"),
+					resource.TestCheckResourceAttr(datasourceName, "db_security_group_name", randName),
+					resource.TestCheckResourceAttr(datasourceName, "parameter_group_name", randName),
+					resource.TestCheckResourceAttr(datasourceName, "status", "available"),
+					resource.TestCheckResourceAttrPair(datasourceName, "address", "nifcloud_db_instance.basic", "address"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDBInstance(t *testing.T, fileName, rName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
@@ -148,6 +178,18 @@ func testAccDBInstance(t *testing.T, fileName, rName string) string {
 	)
 }
 
+func testAccDBInstanceDataSource(t *testing.T, fileName, rName string) string {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+	)
+}
+
 func testAccCheckDBInstanceExists(n string, dbInstance *types.DBInstances) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		saved, ok := s.RootModule().Resources[n]
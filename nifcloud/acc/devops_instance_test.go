@@ -83,6 +83,32 @@ func TestAcc_DevOpsInstance(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceDevOpsInstance_basic(t *testing.T) {
+	resourceName := "nifcloud_devops_instance.basic"
+	datasourceName := "data.nifcloud_devops_instance.basic"
+	randName := prefix + acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccDevOpsInstanceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevOpsInstanceDataSource(t, "testdata/data_devops_instance.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "instance_type", "c-large"),
+					resource.TestCheckResourceAttr(datasourceName, "availability_zone", "east-14"),
+					resource.TestCheckResourceAttrPair(datasourceName, "gitlab_url", resourceName, "gitlab_url"),
+					resource.TestCheckResourceAttrPair(datasourceName, "registry_url", resourceName, "registry_url"),
+					resource.TestCheckResourceAttrPair(datasourceName, "network_id", resourceName, "network_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "private_address", resourceName, "private_address"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDevOpsInstance(t *testing.T, fileName, rName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
@@ -91,6 +117,14 @@ func testAccDevOpsInstance(t *testing.T, fileName, rName string) string {
 	return fmt.Sprintf(string(b), rName, rName, rName, rName)
 }
 
+func testAccDevOpsInstanceDataSource(t *testing.T, fileName, rName string) string {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b), rName, rName, rName)
+}
+
 func testAccCheckDevOpsInstanceExists(n string, instance *types.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		saved, ok := s.RootModule().Resources[n]
@@ -57,6 +57,28 @@ func TestAcc_DnsZone(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceDnsZone_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_dns_zone.basic"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccDnsZoneResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsZone(t, "testdata/data_dns_zone.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", dnsZoneName),
+					resource.TestCheckResourceAttr(datasourceName, "zone_id", dnsZoneName),
+					resource.TestCheckResourceAttr(datasourceName, "comment", "tfacc-memo"),
+					resource.TestCheckResourceAttrSet(datasourceName, "record_count"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name_servers.#", "nifcloud_dns_zone.basic", "name_servers.#"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDnsZone(t *testing.T, fileName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
@@ -69,6 +70,31 @@ func TestAcc_ElasticIP(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceElasticIP_basic(t *testing.T) {
+	byIPAddressName := "data.nifcloud_elastic_ip.by_ip_address"
+	byDescriptionName := "data.nifcloud_elastic_ip.by_description"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccElasticIPResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccElasticIP(t, "testdata/data_elastic_ip.tf"), randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byIPAddressName, "id", "nifcloud_elastic_ip.basic", "public_ip"),
+					resource.TestCheckResourceAttr(byIPAddressName, "ip_type", "false"),
+					resource.TestCheckResourceAttr(byIPAddressName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(byIPAddressName, "description", randName),
+					resource.TestCheckResourceAttr(byIPAddressName, "instance_id", ""),
+					resource.TestCheckResourceAttrPair(byDescriptionName, "public_ip", "nifcloud_elastic_ip.basic", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
 func testAccElasticIP(t *testing.T, fileName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
@@ -0,0 +1,193 @@
+package acc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	resource.AddTestSweepers("nifcloud_image_from_instance", &resource.Sweeper{
+		Name: "nifcloud_image_from_instance",
+		F:    testSweepImageFromInstance,
+	})
+}
+
+func TestAcc_ImageFromInstance(t *testing.T) {
+	var image types.ImagesSet
+
+	resourceName := "nifcloud_image_from_instance.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccImageFromInstanceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageFromInstance(t, "testdata/image_from_instance.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageFromInstanceExists(resourceName, &image),
+					testAccCheckImageFromInstanceValues(&image, randName, "memo"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", randName),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(resourceName, "no_reboot", "true"),
+				),
+			},
+			{
+				Config: testAccImageFromInstance(t, "testdata/image_from_instance_update.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageFromInstanceExists(resourceName, &image),
+					testAccCheckImageFromInstanceValues(&image, randName+"-upd", "memo-upd"),
+					resource.TestCheckResourceAttr(resourceName, "name", randName+"-upd"),
+					resource.TestCheckResourceAttr(resourceName, "description", "memo-upd"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"instance_id",
+					"no_reboot",
+				},
+			},
+		},
+	})
+}
+
+func testAccImageFromInstance(t *testing.T, fileName, rName string) string {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(string(b),
+		rName,
+		rName,
+		rName,
+		rName,
+	)
+}
+
+func testAccCheckImageFromInstanceExists(n string, image *types.ImagesSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		saved, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("no image resource: %s", n)
+		}
+
+		if saved.Primary.ID == "" {
+			return fmt.Errorf("no image id is set")
+		}
+
+		svc := testAccProvider.Meta().(*client.Client).Computing
+		res, err := svc.DescribeImages(context.Background(), &computing.DescribeImagesInput{
+			ImageId: []string{saved.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res == nil || len(res.ImagesSet) == 0 {
+			return fmt.Errorf("image does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		foundImage := res.ImagesSet[0]
+
+		if nifcloud.ToString(foundImage.ImageId) != saved.Primary.ID {
+			return fmt.Errorf("image does not found in cloud: %s", saved.Primary.ID)
+		}
+
+		*image = foundImage
+		return nil
+	}
+}
+
+func testAccCheckImageFromInstanceValues(image *types.ImagesSet, name, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nifcloud.ToString(image.Name) != name {
+			return fmt.Errorf("bad name state, expected \"%s\", got: %#v", name, nifcloud.ToString(image.Name))
+		}
+
+		if nifcloud.ToString(image.Description) != description {
+			return fmt.Errorf("bad description state, expected \"%s\", got: %#v", description, nifcloud.ToString(image.Description))
+		}
+
+		if nifcloud.ToString(image.ImageState) != "available" {
+			return fmt.Errorf("bad image_state state, expected \"available\", got: %#v", nifcloud.ToString(image.ImageState))
+		}
+		return nil
+	}
+}
+
+func testAccImageFromInstanceResourceDestroy(s *terraform.State) error {
+	svc := testAccProvider.Meta().(*client.Client).Computing
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nifcloud_image_from_instance" {
+			continue
+		}
+
+		res, err := svc.DescribeImages(context.Background(), &computing.DescribeImagesInput{
+			ImageId: []string{rs.Primary.ID},
+		})
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.Image" {
+				return nil
+			}
+			return fmt.Errorf("failed DescribeImagesRequest: %s", err)
+		}
+
+		if len(res.ImagesSet) > 0 {
+			return fmt.Errorf("image (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testSweepImageFromInstance(region string) error {
+	ctx := context.Background()
+	svc := sharedClientForRegion(region).Computing
+
+	res, err := svc.DescribeImages(ctx, &computing.DescribeImagesInput{
+		Owner: []string{"self"},
+	})
+	if err != nil {
+		return err
+	}
+
+	var sweepImages []string
+	for _, i := range res.ImagesSet {
+		if strings.HasPrefix(nifcloud.ToString(i.Name), prefix) {
+			sweepImages = append(sweepImages, nifcloud.ToString(i.ImageId))
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, n := range sweepImages {
+		imageID := n
+		eg.Go(func() error {
+			_, err := svc.DeleteImage(ctx, &computing.DeleteImageInput{
+				ImageId: nifcloud.String(imageID),
+			})
+			return err
+		})
+	}
+	return eg.Wait()
+}
@@ -3,6 +3,7 @@ package acc
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,6 +31,29 @@ func TestAccDatasourceImage_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceImage_filter(t *testing.T) {
+	datasourceName := "data.nifcloud_image.filter"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageDataSource(t, "testdata/data_image_filter.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageDataSourceID(datasourceName),
+					resource.TestCheckResourceAttrSet(datasourceName, "image_id"),
+					resource.TestMatchResourceAttr(datasourceName, "image_name", regexp.MustCompile(`^Ubuntu Server 22\.04`)),
+					resource.TestCheckResourceAttr(datasourceName, "state", "available"),
+					resource.TestCheckResourceAttrSet(datasourceName, "platform"),
+					resource.TestCheckResourceAttrSet(datasourceName, "architecture"),
+				),
+			},
+		},
+	})
+}
+
 func testAccImageDataSource(t *testing.T, fileName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
@@ -0,0 +1,27 @@
+package acc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceImages_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_images.basic"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageDataSource(t, "testdata/data_images.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "ids.0"),
+					resource.TestCheckResourceAttrSet(datasourceName, "names.0"),
+				),
+			},
+		},
+	})
+}
@@ -184,6 +184,65 @@ func TestAcc_Instance_MultiIPAddress(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceInstance_basic(t *testing.T) {
+	byIDName := "data.nifcloud_instance.by_id"
+	byFilterName := "data.nifcloud_instance.by_filter"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccInstanceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstance(t, "testdata/data_instance.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(byIDName, "id", randName),
+					resource.TestCheckResourceAttr(byIDName, "instance_id", randName),
+					resource.TestCheckResourceAttr(byIDName, "description", "memo"),
+					resource.TestCheckResourceAttr(byIDName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(byIDName, "accounting_type", "2"),
+					resource.TestCheckResourceAttr(byIDName, "image_id", "221"),
+					resource.TestCheckResourceAttr(byIDName, "instance_type", "small"),
+					resource.TestCheckResourceAttr(byIDName, "key_name", randName),
+					resource.TestCheckResourceAttr(byIDName, "security_group", randName),
+					resource.TestCheckResourceAttr(byIDName, "instance_state", "running"),
+					resource.TestCheckResourceAttrSet(byIDName, "public_ip"),
+					resource.TestCheckResourceAttrSet(byIDName, "private_ip"),
+					resource.TestCheckResourceAttrSet(byIDName, "unique_id"),
+					resource.TestCheckResourceAttr(byIDName, "network_interface.#", "2"),
+					resource.TestCheckResourceAttrPair(byFilterName, "id", byIDName, "id"),
+					resource.TestCheckResourceAttrPair(byFilterName, "unique_id", byIDName, "unique_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceInstances_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_instances.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccInstanceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstance(t, "testdata/data_instances.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "ids.0", randName),
+					resource.TestCheckResourceAttrPair(datasourceName, "unique_ids.0", "nifcloud_instance.basic", "unique_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "private_ips.0", "nifcloud_instance.basic", "private_ip"),
+					resource.TestCheckResourceAttrPair(datasourceName, "public_ips.0", "nifcloud_instance.basic", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstance(t *testing.T, fileName, rName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
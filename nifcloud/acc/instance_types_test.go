@@ -0,0 +1,39 @@
+package acc
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceInstanceTypes_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_instance_types.e2"
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypesDataSource(t, "testdata/data_instance_types.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "names.0", "e2-small8"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_types.0.series", "e2"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_types.0.vcpu", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_types.0.memory", "8"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "names.*", "e2-large16"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceTypesDataSource(t *testing.T, fileName string) string {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -73,6 +73,36 @@ func TestAcc_PrivateLan(t *testing.T) {
 	})
 }
 
+func TestAccDatasourcePrivateLan_basic(t *testing.T) {
+	byNameName := "data.nifcloud_private_lan.by_name"
+	byIDName := "data.nifcloud_private_lan.by_id"
+	byCidrName := "data.nifcloud_private_lan.by_cidr"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccPrivateLanResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateLan(t, "testdata/data_private_lan.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byNameName, "id", "nifcloud_private_lan.basic", "network_id"),
+					resource.TestCheckResourceAttr(byNameName, "private_lan_name", randName),
+					resource.TestCheckResourceAttr(byNameName, "cidr_block", "172.31.201.0/24"),
+					resource.TestCheckResourceAttr(byNameName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(byNameName, "accounting_type", "2"),
+					resource.TestCheckResourceAttr(byNameName, "description", "tfacc-memo"),
+					resource.TestCheckResourceAttr(byNameName, "state", "available"),
+					resource.TestCheckResourceAttr(byNameName, "router_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(byIDName, "private_lan_name", byNameName, "private_lan_name"),
+					resource.TestCheckResourceAttrPair(byCidrName, "network_id", byNameName, "network_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPrivateLan(t *testing.T, fileName string, rName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
@@ -90,6 +90,39 @@ func TestAcc_Router(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceRouter_basic(t *testing.T) {
+	byNameName := "data.nifcloud_router.by_name"
+	byIDName := "data.nifcloud_router.by_id"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccRouterResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouter(t, "testdata/data_router.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byNameName, "id", "nifcloud_router.basic", "router_id"),
+					resource.TestCheckResourceAttr(byNameName, "name", randName),
+					resource.TestCheckResourceAttr(byNameName, "description", "memo"),
+					resource.TestCheckResourceAttr(byNameName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(byNameName, "accounting_type", "2"),
+					resource.TestCheckResourceAttr(byNameName, "type", "small"),
+					resource.TestCheckResourceAttr(byNameName, "security_group", randName),
+					resource.TestCheckResourceAttr(byNameName, "network_interface.#", "1"),
+					resource.TestCheckResourceAttrPair(byNameName, "network_interface.0.network_id", "nifcloud_private_lan.basic", "network_id"),
+					resource.TestCheckResourceAttr(byNameName, "network_interface.0.ip_address", "192.168.1.1"),
+					resource.TestCheckResourceAttr(byNameName, "network_interface.0.dhcp", "true"),
+					resource.TestCheckResourceAttrPair(byNameName, "network_interface.0.dhcp_config_id", "nifcloud_dhcp_config.basic", "id"),
+					resource.TestCheckResourceAttrPair(byNameName, "network_interface.0.dhcp_options_id", "nifcloud_dhcp_option.basic", "id"),
+					resource.TestCheckResourceAttrPair(byIDName, "name", byNameName, "name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRouter(t *testing.T, fileName, rName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
@@ -78,6 +78,37 @@ func TestAcc_SecurityGroup(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceSecurityGroup_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_security_group.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccSecurityGroupResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityGroup(t, "testdata/data_security_group.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "group_name", randName),
+					resource.TestCheckResourceAttr(datasourceName, "description", "memo"),
+					resource.TestCheckResourceAttr(datasourceName, "availability_zone", "east-21"),
+					resource.TestCheckResourceAttr(datasourceName, "log_limit", "1000"),
+					resource.TestCheckResourceAttrSet(datasourceName, "state"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.0.type", "IN"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.0.from_port", "22"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.0.cidr_ip", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(datasourceName, "rule.0.description", "memo"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSecurityGroup(t *testing.T, fileName, groupName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
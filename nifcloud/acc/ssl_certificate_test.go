@@ -78,6 +78,39 @@ func TestAcc_SSLCertificate(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceSSLCertificate_basic(t *testing.T) {
+	resourceName := "nifcloud_ssl_certificate.basic"
+	byFqdnName := "data.nifcloud_ssl_certificate.by_fqdn"
+	byDescriptionName := "data.nifcloud_ssl_certificate.by_description"
+	randName := prefix + acctest.RandString(10)
+
+	caKey := helper.GeneratePrivateKey(t, 4096)
+	caCert := helper.GenerateSelfSignedCertificateAuthority(t, caKey)
+	key := helper.GeneratePrivateKey(t, 4096)
+	cert := helper.GenerateCertificate(t, caKey, caCert, key, randName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccSSLCertificateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSLCertificate(t, "testdata/data_ssl_certificate.tf", cert, key, caCert),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byFqdnName, "id", resourceName, "fqdn_id"),
+					resource.TestCheckResourceAttrPair(byFqdnName, "fqdn_id", resourceName, "fqdn_id"),
+					resource.TestCheckResourceAttr(byFqdnName, "fqdn", randName),
+					resource.TestCheckResourceAttr(byFqdnName, "description", "memo"),
+					resource.TestCheckResourceAttrSet(byFqdnName, "issuer"),
+					resource.TestCheckResourceAttrSet(byFqdnName, "start_date"),
+					resource.TestCheckResourceAttrSet(byFqdnName, "end_date"),
+					resource.TestCheckResourceAttrPair(byDescriptionName, "fqdn_id", resourceName, "fqdn_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSSLCertificate(t *testing.T, fileName, certificate, key, ca string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
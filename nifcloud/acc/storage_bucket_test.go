@@ -68,6 +68,78 @@ func TestAcc_StorageBucket(t *testing.T) {
 	})
 }
 
+func TestAccDatasourceStorageBucket_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_storage_bucket.basic"
+	randName := prefix + acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccStorageBucketResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket(t, "testdata/data_storage_bucket.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", randName),
+					resource.TestCheckResourceAttr(datasourceName, "region", "jp-east-1"),
+					resource.TestCheckResourceAttr(datasourceName, "endpoint", "https://jp-east-1.storage.api.nifcloud.com/"+randName),
+					resource.TestCheckResourceAttrSet(datasourceName, "creation_date"),
+					resource.TestCheckResourceAttr(datasourceName, "versioning.0.enabled", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceStorageObjects_basic(t *testing.T) {
+	datasourceName := "data.nifcloud_storage_objects.basic"
+	randName := prefix + acctest.RandString(7)
+	key := "artifacts/app.zip"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactory,
+		CheckDestroy:      testAccStorageBucketResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket(t, "testdata/storage_bucket.tf", randName),
+			},
+			{
+				PreConfig: func() {
+					svc := testAccProvider.Meta().(*client.Client).Storage
+					if _, err := svc.PutObject(context.Background(), &storage.PutObjectInput{
+						Bucket: nifcloud.String(randName),
+						Object: nifcloud.String(key),
+						Body:   []byte("test"),
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccStorageBucket(t, "testdata/data_storage_objects.tf", randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "keys.0", key),
+					resource.TestCheckResourceAttr(datasourceName, "latest_key", key),
+					resource.TestCheckResourceAttr(datasourceName, "objects.0.size", "4"),
+				),
+			},
+			{
+				PreConfig: func() {
+					svc := testAccProvider.Meta().(*client.Client).Storage
+					if _, err := svc.DeleteObject(context.Background(), &storage.DeleteObjectInput{
+						Bucket: nifcloud.String(randName),
+						Object: nifcloud.String(key),
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccStorageBucket(t, "testdata/storage_bucket.tf", randName),
+			},
+		},
+	})
+}
+
 func testAccStorageBucket(t *testing.T, fileName, rName string) string {
 	b, err := os.ReadFile(fileName)
 	if err != nil {
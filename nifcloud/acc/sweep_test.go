@@ -4,7 +4,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
@@ -20,19 +19,15 @@ func sharedClientForRegion(region string) *client.Client {
 		os.Getenv("NIFCLOUD_SECRET_ACCESS_KEY"),
 		region,
 	)
-	cfg.Retryer = func() aws.Retryer {
-		return aws.NopRetryer{}
-	}
+	cfg.Retryer = client.NewRetryer(client.RetryOptions{})
 
 	storageCfg := nifcloud.NewConfig(
 		os.Getenv("NIFCLOUD_STORAGE_ACCESS_KEY_ID"),
 		os.Getenv("NIFCLOUD_STORAGE_SECRET_ACCESS_KEY"),
 		region,
 	)
-	storageCfg.Retryer = func() aws.Retryer {
-		return aws.NopRetryer{}
-	}
+	storageCfg.Retryer = client.NewRetryer(client.RetryOptions{})
 
-	client := client.New(cfg, storageCfg)
+	client := client.New(cfg, storageCfg, client.Options{})
 	return client
 }
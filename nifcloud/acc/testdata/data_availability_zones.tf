@@ -0,0 +1,7 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_availability_zones" "available" {
+  state = "available"
+}
@@ -0,0 +1,8 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_db_engine_versions" "mysql80" {
+  engine         = "MySQL"
+  version_prefix = "8.0"
+}
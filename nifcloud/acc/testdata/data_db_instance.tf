@@ -0,0 +1,40 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_db_instance" "basic" {
+  identifier = nifcloud_db_instance.basic.identifier
+}
+
+resource "nifcloud_db_instance" "basic" {
+  availability_zone       = "east-21"
+  instance_class          = "db.large"
+  db_name                 = "baz"
+  username                = "for"
+  password                = "barbarbar"
+  engine                  = "MySQL"
+  engine_version          = "5.7.15"
+  allocated_storage       = 50
+  storage_type            = 0
+  identifier              = "%s"
+  backup_retention_period = 1
+  backup_window           = "00:00-08:00"
+  maintenance_window      = "sun:23:00-sun:23:30"
+  multi_az                = true
+  port                    = 3306
+  publicly_accessible     = true
+  db_security_group_name  = nifcloud_db_security_group.basic.id
+  parameter_group_name    = nifcloud_db_parameter_group.basic.id
+  skip_final_snapshot     = true
+  apply_immediately       = true
+}
+
+resource "nifcloud_db_parameter_group" "basic" {
+  name   = "%s"
+  family = "mysql5.7"
+}
+
+resource "nifcloud_db_security_group" "basic" {
+  group_name        = "%s"
+  availability_zone = "east-21"
+}
@@ -0,0 +1,8 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_db_instance_classes" "mysql" {
+  engine            = "MySQL"
+  availability_zone = "east-21"
+}
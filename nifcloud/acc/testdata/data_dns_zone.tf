@@ -0,0 +1,13 @@
+data "nifcloud_dns_zone" "basic" {
+  name = nifcloud_dns_zone.basic.name
+}
+
+resource "nifcloud_dns_zone" "basic" {
+  name    = var.dns_zone_name
+  comment = "tfacc-memo"
+}
+
+variable "dns_zone_name" {
+    description = "test dns zone"
+    type        = string
+}
@@ -0,0 +1,17 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_elastic_ip" "by_ip_address" {
+  ip_address = nifcloud_elastic_ip.basic.public_ip
+}
+
+data "nifcloud_elastic_ip" "by_description" {
+  description = nifcloud_elastic_ip.basic.description
+}
+
+resource "nifcloud_elastic_ip" "basic" {
+  ip_type           = false
+  availability_zone = "east-21"
+  description       = "%s"
+}
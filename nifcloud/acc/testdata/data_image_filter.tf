@@ -0,0 +1,10 @@
+data "nifcloud_image" "filter" {
+  owner       = "niftycloud"
+  name_regex  = "^Ubuntu Server 22\\.04"
+  most_recent = true
+
+  filter {
+    name   = "state"
+    values = ["available"]
+  }
+}
@@ -0,0 +1,9 @@
+data "nifcloud_images" "basic" {
+  owner      = "niftycloud"
+  name_regex = "^Ubuntu Server"
+
+  filter {
+    name   = "state"
+    values = ["available"]
+  }
+}
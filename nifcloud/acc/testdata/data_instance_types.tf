@@ -0,0 +1,8 @@
+provider "nifcloud" {
+  region = "jp-east-1"
+}
+
+data "nifcloud_instance_types" "e2" {
+  series     = "e2"
+  min_memory = 8
+}
@@ -0,0 +1,23 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_private_lan" "by_name" {
+  private_lan_name = nifcloud_private_lan.basic.private_lan_name
+}
+
+data "nifcloud_private_lan" "by_id" {
+  network_id = nifcloud_private_lan.basic.network_id
+}
+
+data "nifcloud_private_lan" "by_cidr" {
+  cidr_block = nifcloud_private_lan.basic.cidr_block
+}
+
+resource "nifcloud_private_lan" "basic" {
+  private_lan_name  = "%s"
+  description       = "tfacc-memo"
+  availability_zone = "east-21"
+  cidr_block        = "172.31.201.0/24"
+  accounting_type   = "2"
+}
@@ -0,0 +1,5 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_regions" "all" {}
@@ -0,0 +1,25 @@
+provider "nifcloud" {
+  region = "jp-east-2"
+}
+
+data "nifcloud_security_group" "basic" {
+  group_name = nifcloud_security_group_rule.basic.security_group_names[0]
+}
+
+resource "nifcloud_security_group" "basic" {
+  group_name             = "%s"
+  description            = "memo"
+  availability_zone      = "east-21"
+  log_limit              = 1000
+  revoke_rules_on_delete = false
+}
+
+resource "nifcloud_security_group_rule" "basic" {
+  security_group_names = [nifcloud_security_group.basic.group_name]
+  type                 = "IN"
+  from_port            = 22
+  to_port              = 22
+  protocol             = "TCP"
+  cidr_ip              = "0.0.0.0/0"
+  description          = "memo"
+}
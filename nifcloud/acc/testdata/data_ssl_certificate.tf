@@ -0,0 +1,18 @@
+data "nifcloud_ssl_certificate" "by_fqdn" {
+  fqdn = nifcloud_ssl_certificate.basic.fqdn
+}
+
+data "nifcloud_ssl_certificate" "by_description" {
+  fqdn        = nifcloud_ssl_certificate.basic.fqdn
+  description = nifcloud_ssl_certificate.basic.description
+}
+
+resource "nifcloud_ssl_certificate" "basic" {
+  certificate = <<EOT
+%sEOT
+  key         = <<EOT
+%sEOT
+  ca          = <<EOT
+%sEOT
+  description = "memo"
+}
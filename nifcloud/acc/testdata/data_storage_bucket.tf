@@ -0,0 +1,15 @@
+provider "nifcloud" {
+  storage_region = "jp-east-1"
+}
+
+data "nifcloud_storage_bucket" "basic" {
+  bucket = nifcloud_storage_bucket.basic.bucket
+}
+
+resource "nifcloud_storage_bucket" "basic" {
+  bucket = "%s"
+
+  versioning {
+    enabled = true
+  }
+}
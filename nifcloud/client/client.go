@@ -22,18 +22,115 @@ type Client struct {
 	Storage      *storage.Client
 	DevOps       *devops.Client
 	DevOpsRunner *devopsrunner.Client
+
+	// DefaultAvailabilityZone is the availability zone used when the resource attribute is not set.
+	DefaultAvailabilityZone string
+
+	// DefaultAccountingType is the accounting type used when the resource attribute is not set.
+	DefaultAccountingType string
+
+	// StorageRegion is the region of the storage client.
+	StorageRegion string
+
+	// StorageEndpoint is the endpoint URL of the storage client.
+	// It is empty when the endpoint cannot be resolved.
+	StorageEndpoint string
+
+	// region is the region of the clients except for the storage client.
+	region string
+}
+
+// Endpoints is the set of custom endpoint URLs for each service.
+// An empty value means that the default endpoint is used.
+type Endpoints struct {
+	Computing    string
+	RDB          string
+	NAS          string
+	DNS          string
+	ESS          string
+	Storage      string
+	DevOps       string
+	DevOpsRunner string
+}
+
+// Options is the optional configuration of Client.
+type Options struct {
+	// Endpoints is the set of custom endpoint URLs.
+	Endpoints Endpoints
+
+	// ValidateStorageCredentials enables the validation of the storage credentials.
+	// The validation is performed once before the first storage request.
+	ValidateStorageCredentials bool
 }
 
 // New return Client
-func New(cfg nifcloud.Config, storageCfg nifcloud.Config) *Client {
+func New(cfg nifcloud.Config, storageCfg nifcloud.Config, opts Options) *Client {
+	endpoints := opts.Endpoints
+	storageOptFn := func(o *storage.Options) {
+		if endpoints.Storage != "" {
+			o.EndpointResolver = storage.EndpointResolverFromURL(endpoints.Storage)
+		}
+	}
+
+	storageClient := storage.NewFromConfig(storageCfg, storageOptFn)
+	if opts.ValidateStorageCredentials {
+		v := &storageCredentialsValidator{svc: storageClient, region: storageCfg.Region}
+		storageClient = storage.NewFromConfig(storageCfg, storageOptFn, func(o *storage.Options) {
+			o.APIOptions = append(o.APIOptions, v.addToStack)
+		})
+	}
+
 	return &Client{
-		Computing:    computing.NewFromConfig(cfg),
-		RDB:          rdb.NewFromConfig(cfg),
-		NAS:          nas.NewFromConfig(cfg),
-		DNS:          dns.NewFromConfig(cfg),
-		ESS:          ess.NewFromConfig(cfg),
-		Storage:      storage.NewFromConfig(storageCfg),
-		DevOps:       devops.NewFromConfig(cfg),
-		DevOpsRunner: devopsrunner.NewFromConfig(cfg),
+		region: cfg.Region,
+		Computing: computing.NewFromConfig(cfg, func(o *computing.Options) {
+			if endpoints.Computing != "" {
+				o.EndpointResolver = computing.EndpointResolverFromURL(endpoints.Computing)
+			}
+		}),
+		RDB: rdb.NewFromConfig(cfg, func(o *rdb.Options) {
+			if endpoints.RDB != "" {
+				o.EndpointResolver = rdb.EndpointResolverFromURL(endpoints.RDB)
+			}
+		}),
+		NAS: nas.NewFromConfig(cfg, func(o *nas.Options) {
+			if endpoints.NAS != "" {
+				o.EndpointResolver = nas.EndpointResolverFromURL(endpoints.NAS)
+			}
+		}),
+		DNS: dns.NewFromConfig(cfg, func(o *dns.Options) {
+			if endpoints.DNS != "" {
+				o.EndpointResolver = dns.EndpointResolverFromURL(endpoints.DNS)
+			}
+		}),
+		ESS: ess.NewFromConfig(cfg, func(o *ess.Options) {
+			if endpoints.ESS != "" {
+				o.EndpointResolver = ess.EndpointResolverFromURL(endpoints.ESS)
+			}
+		}),
+		Storage:         storageClient,
+		StorageRegion:   storageCfg.Region,
+		StorageEndpoint: resolveStorageEndpoint(storageCfg.Region, endpoints.Storage),
+		DevOps: devops.NewFromConfig(cfg, func(o *devops.Options) {
+			if endpoints.DevOps != "" {
+				o.EndpointResolver = devops.EndpointResolverFromURL(endpoints.DevOps)
+			}
+		}),
+		DevOpsRunner: devopsrunner.NewFromConfig(cfg, func(o *devopsrunner.Options) {
+			if endpoints.DevOpsRunner != "" {
+				o.EndpointResolver = devopsrunner.EndpointResolverFromURL(endpoints.DevOpsRunner)
+			}
+		}),
+	}
+}
+
+func resolveStorageEndpoint(region, customEndpoint string) string {
+	if customEndpoint != "" {
+		return customEndpoint
+	}
+
+	endpoint, err := storage.NewDefaultEndpointResolver().ResolveEndpoint(region, storage.EndpointResolverOptions{})
+	if err != nil {
+		return ""
 	}
+	return endpoint.URL
 }
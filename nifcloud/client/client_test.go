@@ -0,0 +1,39 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveStorageEndpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		region         string
+		customEndpoint string
+		want           string
+	}{
+		{
+			name:   "resolves the default endpoint of the region",
+			region: "jp-east-1",
+			want:   "https://jp-east-1.storage.api.nifcloud.com",
+		},
+		{
+			name:           "returns the custom endpoint",
+			region:         "jp-east-1",
+			customEndpoint: "http://localhost:8080",
+			want:           "http://localhost:8080",
+		},
+		{
+			name: "returns empty when the region is not set",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveStorageEndpoint(tt.region, tt.customEndpoint)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
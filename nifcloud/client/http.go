@@ -0,0 +1,95 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+)
+
+// HTTPOptions is the configuration of the HTTP client used by the service clients.
+type HTTPOptions struct {
+	// ProxyURL is the URL of the proxy. If empty, the proxy is taken from the environment variables.
+	ProxyURL string
+
+	// CABundle is the file path or the PEM encoded content of the CA certificates.
+	CABundle string
+
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
+
+	// RequestTimeout is the timeout of each HTTP request. Zero means no timeout.
+	RequestTimeout time.Duration
+}
+
+// NewHTTPClient returns the HTTP client configured with HTTPOptions.
+func NewHTTPClient(opts HTTPOptions) (*awshttp.BuildableClient, error) {
+	var proxy func(*http.Request) (*url.URL, error)
+	if opts.ProxyURL != "" {
+		u, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy_url: %s", err)
+		}
+		proxy = http.ProxyURL(u)
+	}
+
+	var rootCAs *x509.CertPool
+	if opts.CABundle != "" {
+		pem, err := readCABundle(opts.CABundle)
+		if err != nil {
+			return nil, err
+		}
+
+		rootCAs, err = x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to load ca_bundle: no valid certificates found")
+		}
+	}
+
+	httpClient := awshttp.NewBuildableClient().WithTransportOptions(func(t *http.Transport) {
+		if proxy != nil {
+			t.Proxy = proxy
+		}
+
+		if rootCAs != nil || opts.InsecureSkipVerify {
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			if rootCAs != nil {
+				t.TLSClientConfig.RootCAs = rootCAs
+			}
+			if opts.InsecureSkipVerify {
+				t.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec // explicitly enabled by the user for development
+			}
+		}
+	})
+
+	if opts.RequestTimeout > 0 {
+		httpClient = httpClient.WithTimeout(opts.RequestTimeout)
+	}
+
+	return httpClient, nil
+}
+
+// readCABundle returns the PEM content. The value is treated as PEM if it contains a PEM header,
+// otherwise as a file path.
+func readCABundle(v string) ([]byte, error) {
+	if strings.Contains(v, "-----BEGIN") {
+		return []byte(v), nil
+	}
+
+	b, err := os.ReadFile(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ca_bundle: %s", err)
+	}
+	return b, nil
+}
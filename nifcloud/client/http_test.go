@@ -0,0 +1,69 @@
+package client
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(caPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		opts    HTTPOptions
+		wantErr bool
+	}{
+		"untrusted certificate": {
+			opts:    HTTPOptions{},
+			wantErr: true,
+		},
+		"ca bundle pem": {
+			opts: HTTPOptions{CABundle: caPEM},
+		},
+		"ca bundle file": {
+			opts: HTTPOptions{CABundle: caFile, RequestTimeout: 10 * time.Second},
+		},
+		"insecure skip verify": {
+			opts: HTTPOptions{InsecureSkipVerify: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewHTTPClient(tc.opts)
+			assert.NoError(t, err)
+
+			req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+			res, err := c.Do(req)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			res.Body.Close()
+		})
+	}
+}
+
+func TestNewHTTPClient_invalidCABundle(t *testing.T) {
+	_, err := NewHTTPClient(HTTPOptions{CABundle: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----"})
+	assert.Error(t, err)
+
+	_, err = NewHTTPClient(HTTPOptions{CABundle: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
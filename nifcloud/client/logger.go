@@ -0,0 +1,89 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/logging"
+)
+
+const (
+	// LogLevelNone disables the request/response logging.
+	LogLevelNone = "none"
+
+	// LogLevelHeaders logs the request/response without the body.
+	LogLevelHeaders = "headers"
+
+	// LogLevelBodies logs the request/response with the body.
+	LogLevelBodies = "bodies"
+)
+
+// LogLevels is the list of valid log levels.
+var LogLevels = []string{LogLevelNone, LogLevelHeaders, LogLevelBodies}
+
+// LogMode returns aws.ClientLogMode for the log level.
+func LogMode(level string) aws.ClientLogMode {
+	switch level {
+	case LogLevelNone:
+		return 0
+	case LogLevelHeaders:
+		return aws.LogRequest | aws.LogResponse
+	default:
+		return aws.LogRequestWithBody | aws.LogResponseWithBody
+	}
+}
+
+const redacted = "***"
+
+var redactPatterns = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	// Query parameters and form values (e.g. Password, RemoteUser.1.Password, IpsecConfiguration.PreSharedKey, Key).
+	{
+		re:   regexp.MustCompile(`((?:^|[?&\s])(?:[\w.]+\.)?(?:Password|MasterUserPassword|ReplicationUserPassword|PreSharedKey|Signature)=)[^&\s]*`),
+		repl: "${1}" + redacted,
+	},
+	{
+		re:   regexp.MustCompile(`((?:^|[?&\s])Key=)[^&\s]*`),
+		repl: "${1}" + redacted,
+	},
+	// JSON fields (e.g. DevOps initialRootPassword, DevOps Runner authenticationToken and token).
+	{
+		re:   regexp.MustCompile(`("(?:password|initialRootPassword|authenticationToken|token)"\s*:\s*)"(?:[^"\\]|\\.)*"`),
+		repl: `${1}"` + redacted + `"`,
+	},
+	// XML elements (e.g. the private key of a key pair). Computing responses use lowerCamel tags such as preSharedKey.
+	{
+		re:   regexp.MustCompile(`(?i)(<(?:Password|MasterUserPassword|ReplicationUserPassword|PreSharedKey|keyMaterial)>)[^<]*`),
+		repl: "${1}" + redacted,
+	},
+	// HTTP headers.
+	{
+		re:   regexp.MustCompile(`(?im)^((?:Authorization|X-Amz-Security-Token):[ \t]*)[^\r\n]*`),
+		repl: "${1}" + redacted,
+	},
+}
+
+// Redact masks the sensitive values in the message.
+func Redact(msg string) string {
+	for _, p := range redactPatterns {
+		msg = p.re.ReplaceAllString(msg, p.repl)
+	}
+	return msg
+}
+
+// Logger is a logging.Logger that writes the messages to the standard logger
+// after masking the sensitive values such as passwords, keys and tokens.
+type Logger struct{}
+
+// Logf implements logging.Logger
+func (l Logger) Logf(classification logging.Classification, format string, v ...interface{}) {
+	msg := Redact(fmt.Sprintf(format, v...))
+	if len(classification) != 0 {
+		msg = string(classification) + " " + msg
+	}
+	log.Print(msg)
+}
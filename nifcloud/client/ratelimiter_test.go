@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(2, 2)
+	l.now = func() time.Time { return now }
+
+	// burst
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	// bucket is empty
+	assert.Equal(t, 500*time.Millisecond, l.reserve())
+	assert.Equal(t, time.Second, l.reserve())
+
+	// refill
+	now = now.Add(3 * time.Second)
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 500*time.Millisecond, l.reserve())
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	l := NewRateLimiter(0.001, 1)
+
+	// burst
+	assert.NoError(t, l.Wait(context.Background()))
+
+	// bucket is empty
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, l.Wait(ctx))
+}
+
+func TestRateLimiter_AddToStack(t *testing.T) {
+	noop := func(id string) middleware.FinalizeMiddleware {
+		return middleware.FinalizeMiddlewareFunc(id, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+			middleware.FinalizeOutput, middleware.Metadata, error,
+		) {
+			return next.HandleFinalize(ctx, in)
+		})
+	}
+
+	stack := middleware.NewStack("test", nil)
+	assert.NoError(t, stack.Finalize.Add(noop("Retry"), middleware.After))
+	assert.NoError(t, stack.Finalize.Add(noop("Signing"), middleware.After))
+	assert.NoError(t, stack.Finalize.Add(noop("Other"), middleware.After))
+
+	l := NewRateLimiter(1, 1)
+	assert.NoError(t, l.AddToStack(stack))
+	assert.Equal(t, []string{"Retry", "RateLimiter", "Signing", "Other"}, stack.Finalize.List())
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default maximum number of attempts for an API request.
+	DefaultRetryMaxAttempts = 5
+
+	// DefaultRetryMaxBackoff is the default maximum back off delay between attempts.
+	DefaultRetryMaxBackoff = 20 * time.Second
+)
+
+// DefaultRetryableErrorCodes is the set of NIFCLOUD error codes that are
+// considered transient and are retried by default.
+var DefaultRetryableErrorCodes = []string{
+	"Server.ResourceIsBusy",
+	"Server.InternalError",
+	"Server.ServiceUnavailable",
+	"Client.RequestLimitExceeded",
+	"Throttling",
+	"RequestLimitExceeded",
+	"ServiceUnavailable",
+	"SlowDown",
+}
+
+// RetryOptions is the configuration of the retryer.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of attempts for an API request.
+	MaxAttempts int
+
+	// MaxBackoff is the maximum back off delay between attempts.
+	MaxBackoff time.Duration
+
+	// RetryableErrorCodes is the additional set of error codes that should be retried.
+	RetryableErrorCodes []string
+}
+
+// NewRetryer returns a function that provides aws.Retryer with exponential backoff.
+// The retryer classifies NIFCLOUD error codes as retryable or terminal.
+func NewRetryer(opts RetryOptions) func() aws.Retryer {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultRetryMaxAttempts
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = DefaultRetryMaxBackoff
+	}
+
+	codes := map[string]struct{}{}
+	for _, c := range DefaultRetryableErrorCodes {
+		codes[c] = struct{}{}
+	}
+	for _, c := range opts.RetryableErrorCodes {
+		codes[c] = struct{}{}
+	}
+
+	return func() aws.Retryer {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.MaxAttempts = opts.MaxAttempts
+			o.MaxBackoff = opts.MaxBackoff
+			o.RateLimiter = noRetryQuota{}
+			o.Retryables = append([]retry.IsErrorRetryable{
+				retry.NoRetryCanceledError{},
+				errorCodeRetryable{codes: codes},
+			}, o.Retryables...)
+		})
+	}
+}
+
+// noRetryQuota is a retry.RateLimiter without a quota.
+// The default retry quota of the SDK is exhausted under sustained throttling,
+// and then the retries fail regardless of MaxAttempts.
+type noRetryQuota struct{}
+
+func (noRetryQuota) GetToken(ctx context.Context, cost uint) (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+func (noRetryQuota) AddTokens(uint) error {
+	return nil
+}
+
+// errorCodeRetryable classifies the NIFCLOUD API errors.
+// Configured codes are retried, the other client errors are terminal,
+// and the rest are left to the default retryables (HTTP status codes and connection errors).
+type errorCodeRetryable struct {
+	codes map[string]struct{}
+}
+
+func (r errorCodeRetryable) IsErrorRetryable(err error) aws.Ternary {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return aws.UnknownTernary
+	}
+
+	if _, ok := r.codes[apiErr.ErrorCode()]; ok {
+		return aws.TrueTernary
+	}
+
+	if strings.HasPrefix(apiErr.ErrorCode(), "Client.") {
+		return aws.FalseTernary
+	}
+
+	return aws.UnknownTernary
+}
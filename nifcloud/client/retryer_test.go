@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRetryer(t *testing.T) {
+	retryer := NewRetryer(RetryOptions{
+		MaxAttempts:         3,
+		RetryableErrorCodes: []string{"Client.Custom.Retryable"},
+	})()
+
+	cases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"resource is busy": {
+			err:      &smithy.GenericAPIError{Code: "Server.ResourceIsBusy"},
+			expected: true,
+		},
+		"request limit exceeded": {
+			err:      &smithy.GenericAPIError{Code: "Client.RequestLimitExceeded"},
+			expected: true,
+		},
+		"custom retryable code": {
+			err:      &smithy.GenericAPIError{Code: "Client.Custom.Retryable"},
+			expected: true,
+		},
+		"terminal client error": {
+			err:      &smithy.GenericAPIError{Code: "Client.InvalidParameterNotFound.Instance"},
+			expected: false,
+		},
+		"canceled": {
+			err:      &smithy.CanceledError{Err: context.Canceled},
+			expected: false,
+		},
+		"unknown error": {
+			err:      errors.New("unknown"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, retryer.IsErrorRetryable(tc.err))
+		})
+	}
+
+	assert.Equal(t, 3, retryer.MaxAttempts())
+}
+
+func TestNewRetryer_defaults(t *testing.T) {
+	retryer := NewRetryer(RetryOptions{})()
+
+	assert.Equal(t, DefaultRetryMaxAttempts, retryer.MaxAttempts())
+}
+
+func TestNewRetryer_noRetryQuota(t *testing.T) {
+	retryer := NewRetryer(RetryOptions{})()
+	throttled := &smithy.GenericAPIError{Code: "Client.RequestLimitExceeded"}
+
+	// The default retry quota of the SDK allows only 100 retries.
+	for i := 0; i < 200; i++ {
+		release, err := retryer.GetRetryToken(context.Background(), throttled)
+		assert.NoError(t, err)
+		assert.NoError(t, release(throttled))
+	}
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+)
+
+// storageAuthFailureErrorCodes is the set of the storage error codes which mean that the credentials are invalid.
+var storageAuthFailureErrorCodes = map[string]struct{}{
+	"InvalidAccessKeyId":    {},
+	"SignatureDoesNotMatch": {},
+}
+
+// ValidateCredentials makes a cheap authenticated call to check that the credentials and the region are valid.
+func (c *Client) ValidateCredentials(ctx context.Context) error {
+	if _, err := c.Computing.DescribeAvailabilityZones(ctx, &computing.DescribeAvailabilityZonesInput{}); err != nil {
+		return fmt.Errorf("failed to validate the credentials for computing service in region %q: %s", c.region, err)
+	}
+	return nil
+}
+
+// storageCredentialsValidator validates the storage credentials before the first storage request.
+// The storage credentials are only needed when the storage resources are used,
+// so they are not validated at the provider configure time.
+// Only the success and the authentication failures are cached, so any other error
+// such as throttling, a canceled context or a denied listing is checked again on the next storage request.
+type storageCredentialsValidator struct {
+	svc       *storage.Client
+	region    string
+	mu        sync.Mutex
+	validated bool
+	err       error
+}
+
+func (v *storageCredentialsValidator) validate(ctx context.Context) error {
+	v.mu.Lock()
+	validated, cachedErr := v.validated, v.err
+	v.mu.Unlock()
+
+	if validated || cachedErr != nil {
+		return cachedErr
+	}
+
+	// The lock is not held during the request, so the concurrent storage requests may validate at the same time.
+	_, err := v.svc.GetService(ctx, &storage.GetServiceInput{})
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if err != nil {
+		wrapped := fmt.Errorf("failed to validate the credentials for storage service in region %q: %s", v.region, err)
+		if isAuthFailure(err) {
+			v.err = wrapped
+		}
+		return wrapped
+	}
+
+	v.validated = true
+	return nil
+}
+
+// isAuthFailure reports whether err means that the storage credentials are invalid.
+func isAuthFailure(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	_, ok := storageAuthFailureErrorCodes[apiErr.ErrorCode()]
+	return ok
+}
+
+func (v *storageCredentialsValidator) addToStack(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+		"StorageCredentialsValidator",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+			out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+		) {
+			if err := v.validate(ctx); err != nil {
+				return out, metadata, err
+			}
+			return next.HandleInitialize(ctx, in)
+		},
+	), middleware.Before)
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(t *testing.T, count *int32) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(count, 1)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`<Response><Errors><Error><Code>Client.SignatureDoesNotMatch</Code><Message>invalid</Message></Error></Errors></Response>`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestValidateCredentials(t *testing.T) {
+	var count int32
+	ts := newTestServer(t, &count)
+
+	cfg := nifcloud.NewConfig("key", "secret", "jp-east-1")
+	c := New(cfg, cfg, Options{Endpoints: Endpoints{Computing: ts.URL}})
+
+	err := c.ValidateCredentials(context.Background())
+	assert.ErrorContains(t, err, `computing service in region "jp-east-1"`)
+}
+
+func newStorageTestServer(t *testing.T, count *int32, code string) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(count, 1)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`<Error><Code>` + code + `</Code><Message>denied</Message></Error>`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestValidateStorageCredentials(t *testing.T) {
+	var count int32
+	ts := newStorageTestServer(t, &count, "SignatureDoesNotMatch")
+
+	cfg := nifcloud.NewConfig("key", "secret", "jp-east-1")
+	c := New(cfg, cfg, Options{
+		Endpoints:                  Endpoints{Storage: ts.URL},
+		ValidateStorageCredentials: true,
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := c.Storage.GetBucketVersioning(context.Background(), &storage.GetBucketVersioningInput{
+			Bucket: nifcloud.String("bucket"),
+		})
+		assert.ErrorContains(t, err, `storage service in region "jp-east-1"`)
+	}
+
+	// The validation request is sent only once and the storage requests are not sent.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestValidateStorageCredentials_transientError(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first validation request fails with a server error.
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<GetServiceResult><Buckets></Buckets></GetServiceResult>`))
+	}))
+	t.Cleanup(ts.Close)
+
+	cfg := nifcloud.NewConfig("key", "secret", "jp-east-1")
+	cfg.Retryer = func() aws.Retryer { return aws.NopRetryer{} }
+	c := New(cfg, cfg, Options{
+		Endpoints:                  Endpoints{Storage: ts.URL},
+		ValidateStorageCredentials: true,
+	})
+
+	_, err := c.Storage.GetService(context.Background(), &storage.GetServiceInput{})
+	assert.ErrorContains(t, err, `storage service in region "jp-east-1"`)
+
+	// The transient error is not cached.
+	_, err = c.Storage.GetService(context.Background(), &storage.GetServiceInput{})
+	assert.NoError(t, err)
+
+	// The success is cached, so the validation request is not sent again.
+	_, err = c.Storage.GetService(context.Background(), &storage.GetServiceInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&count))
+}
+
+func TestValidateStorageCredentials_canceledContext(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<GetServiceResult><Buckets></Buckets></GetServiceResult>`))
+	}))
+	t.Cleanup(ts.Close)
+
+	cfg := nifcloud.NewConfig("key", "secret", "jp-east-1")
+	c := New(cfg, cfg, Options{
+		Endpoints:                  Endpoints{Storage: ts.URL},
+		ValidateStorageCredentials: true,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.Storage.GetService(ctx, &storage.GetServiceInput{})
+	assert.Error(t, err)
+
+	// The context error is not cached.
+	_, err = c.Storage.GetService(context.Background(), &storage.GetServiceInput{})
+	assert.NoError(t, err)
+}
+
+func TestValidateStorageCredentials_accessDenied(t *testing.T) {
+	var count int32
+	ts := newStorageTestServer(t, &count, "AccessDenied")
+
+	cfg := nifcloud.NewConfig("key", "secret", "jp-east-1")
+	c := New(cfg, cfg, Options{
+		Endpoints:                  Endpoints{Storage: ts.URL},
+		ValidateStorageCredentials: true,
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := c.Storage.GetService(context.Background(), &storage.GetServiceInput{})
+		assert.ErrorContains(t, err, `storage service in region "jp-east-1"`)
+	}
+
+	// The error which is not an authentication failure is not cached.
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+}
@@ -0,0 +1,52 @@
+package availabilityzones
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeAvailabilityZonesOutput) error {
+	state := d.Get("state").(string)
+
+	var zones []types.AvailabilityZoneInfo
+	for _, z := range res.AvailabilityZoneInfo {
+		if state != "" && nifcloud.ToString(z.ZoneState) != state {
+			continue
+		}
+		zones = append(zones, z)
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return nifcloud.ToString(zones[i].ZoneName) < nifcloud.ToString(zones[j].ZoneName)
+	})
+
+	names := make([]string, len(zones))
+	list := make([]map[string]interface{}, len(zones))
+	for i, z := range zones {
+		names[i] = nifcloud.ToString(z.ZoneName)
+		list[i] = map[string]interface{}{
+			"name":       nifcloud.ToString(z.ZoneName),
+			"state":      nifcloud.ToString(z.ZoneState),
+			"region":     nifcloud.ToString(z.RegionName),
+			"is_default": nifcloud.ToBool(z.IsDefault),
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	if err := d.Set("zones", list); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package availabilityzones
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	res := &computing.DescribeAvailabilityZonesOutput{
+		AvailabilityZoneInfo: []types.AvailabilityZoneInfo{
+			{
+				ZoneName:   nifcloud.String("east-12"),
+				ZoneState:  nifcloud.String("available"),
+				RegionName: nifcloud.String("jp-east-1"),
+				IsDefault:  nifcloud.Bool(false),
+			},
+			{
+				ZoneName:   nifcloud.String("east-11"),
+				ZoneState:  nifcloud.String("available"),
+				RegionName: nifcloud.String("jp-east-1"),
+				IsDefault:  nifcloud.Bool(true),
+			},
+			{
+				ZoneName:   nifcloud.String("east-13"),
+				ZoneState:  nifcloud.String("unavailable"),
+				RegionName: nifcloud.String("jp-east-1"),
+				IsDefault:  nifcloud.Bool(false),
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []interface{}
+	}{
+		{
+			name: "returns all the availability zones",
+			args: map[string]interface{}{},
+			want: []interface{}{"east-11", "east-12", "east-13"},
+		},
+		{
+			name: "filters the availability zones by the state",
+			args: map[string]interface{}{
+				"state": "available",
+			},
+			want: []interface{}{"east-11", "east-12"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), tt.args)
+
+			err := flatten(rd, res)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, rd.Id())
+			assert.Equal(t, tt.want, rd.Get("names"))
+			assert.Equal(t, map[string]interface{}{
+				"name":       "east-11",
+				"state":      "available",
+				"region":     "jp-east-1",
+				"is_default": true,
+			}, rd.Get("zones.0"))
+		})
+	}
+}
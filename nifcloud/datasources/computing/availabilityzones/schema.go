@@ -0,0 +1,68 @@
+package availabilityzones
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get the list of the availability zones in the region of the provider."
+
+// New returns the nifcloud_availability_zones data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"state": {
+			Type:        schema.TypeString,
+			Description: "The state of the availability zones to filter by. Example: `available`",
+			Optional:    true,
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Description: "The list of the availability zone names sorted in ascending order.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"zones": {
+			Type:        schema.TypeList,
+			Description: "The list of the availability zones in the same order as `names`.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the availability zone.",
+						Computed:    true,
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Description: "The state of the availability zone.",
+						Computed:    true,
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Description: "The region of the availability zone.",
+						Computed:    true,
+					},
+					"is_default": {
+						Type:        schema.TypeBool,
+						Description: "The flag whether the availability zone is the default zone of the region.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package elasticip
+
+import (
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandDescribeAddressesInput(d *schema.ResourceData) *computing.DescribeAddressesInput {
+	input := &computing.DescribeAddressesInput{}
+
+	if v, ok := d.GetOk("ip_address"); ok {
+		ip := net.ParseIP(v.(string))
+		if ip.IsPrivate() {
+			input.PrivateIpAddress = []string{ip.String()}
+		} else {
+			input.PublicIp = []string{ip.String()}
+		}
+	}
+	return input
+}
@@ -0,0 +1,49 @@
+package elasticip
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDescribeAddressesInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeAddressesInput
+	}{
+		{
+			name: "expands the private ip address",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"ip_address": "192.168.0.1",
+			}),
+			want: &computing.DescribeAddressesInput{
+				PrivateIpAddress: []string{"192.168.0.1"},
+			},
+		},
+		{
+			name: "expands the public ip address",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"ip_address": "203.0.113.1",
+			}),
+			want: &computing.DescribeAddressesInput{
+				PublicIp: []string{"203.0.113.1"},
+			},
+		},
+		{
+			name: "describes all elastic ips when the ip address is not specified",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"description": "test_description",
+			}),
+			want: &computing.DescribeAddressesInput{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeAddressesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package elasticip
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, elasticIP *types.AddressesSet) error {
+	isPrivate := nifcloud.ToString(elasticIP.PublicIp) == ""
+	if isPrivate {
+		d.SetId(nifcloud.ToString(elasticIP.PrivateIpAddress))
+	} else {
+		d.SetId(nifcloud.ToString(elasticIP.PublicIp))
+	}
+
+	if err := d.Set("ip_type", isPrivate); err != nil {
+		return err
+	}
+
+	if err := d.Set("private_ip", elasticIP.PrivateIpAddress); err != nil {
+		return err
+	}
+
+	if err := d.Set("public_ip", elasticIP.PublicIp); err != nil {
+		return err
+	}
+
+	if err := d.Set("availability_zone", elasticIP.AvailabilityZone); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", elasticIP.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_id", elasticIP.InstanceId); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_unique_id", elasticIP.InstanceUniqueId); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package elasticip
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name      string
+		elasticIP *types.AddressesSet
+		want      map[string]interface{}
+		wantID    string
+	}{
+		{
+			name: "flattens the public elastic ip",
+			elasticIP: &types.AddressesSet{
+				PublicIp:         nifcloud.String("203.0.113.1"),
+				AvailabilityZone: nifcloud.String("test_availability_zone"),
+				Description:      nifcloud.String("test_description"),
+				InstanceId:       nifcloud.String("test_instance_id"),
+				InstanceUniqueId: nifcloud.String("test_instance_unique_id"),
+			},
+			want: map[string]interface{}{
+				"ip_type":            false,
+				"public_ip":          "203.0.113.1",
+				"private_ip":         "",
+				"availability_zone":  "test_availability_zone",
+				"description":        "test_description",
+				"instance_id":        "test_instance_id",
+				"instance_unique_id": "test_instance_unique_id",
+			},
+			wantID: "203.0.113.1",
+		},
+		{
+			name: "flattens the private elastic ip",
+			elasticIP: &types.AddressesSet{
+				PrivateIpAddress: nifcloud.String("192.168.0.1"),
+				AvailabilityZone: nifcloud.String("test_availability_zone"),
+				Description:      nifcloud.String("test_description"),
+			},
+			want: map[string]interface{}{
+				"ip_type":            true,
+				"public_ip":          "",
+				"private_ip":         "192.168.0.1",
+				"availability_zone":  "test_availability_zone",
+				"description":        "test_description",
+				"instance_id":        "",
+				"instance_unique_id": "",
+			},
+			wantID: "192.168.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+			err := flatten(rd, tt.elasticIP)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, rd.Id())
+			for k, v := range tt.want {
+				assert.Equal(t, v, rd.Get(k), k)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package elasticip
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandDescribeAddressesInput(d)
+
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.DescribeAddresses(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	var elasticIPs []types.AddressesSet
+	for _, a := range res.AddressesSet {
+		if v, ok := d.GetOk("description"); ok && nifcloud.ToString(a.Description) != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("instance_id"); ok && nifcloud.ToString(a.InstanceId) != v.(string) {
+			continue
+		}
+		elasticIPs = append(elasticIPs, a)
+	}
+
+	if len(elasticIPs) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	if len(elasticIPs) > 1 {
+		return diag.FromErr(fmt.Errorf("your query returned more than one result. Please try a more specific search criteria"))
+	}
+
+	if err := flatten(d, &elasticIPs[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package elasticip
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get information about an existing elastic ip."
+
+// New returns the nifcloud_elastic_ip data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip_address": {
+			Type:         schema.TypeString,
+			Description:  "The public or private ip address of the elastic ip.",
+			Optional:     true,
+			ValidateFunc: validation.IsIPv4Address,
+			AtLeastOneOf: []string{"ip_address", "description", "instance_id"},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The elastic ip description.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The instance name which the elastic ip is attached to.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"ip_type": {
+			Type:        schema.TypeBool,
+			Description: "The type of the elastic ip; private ip address(true) or public ip address(false).",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Computed:    true,
+		},
+		"instance_unique_id": {
+			Type:        schema.TypeString,
+			Description: "The unique ID of the instance which the elastic ip is attached to.",
+			Computed:    true,
+		},
+		"private_ip": {
+			Type:        schema.TypeString,
+			Description: "The private ip address.",
+			Computed:    true,
+		},
+		"public_ip": {
+			Type:        schema.TypeString,
+			Description: "The public ip address.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/filter"
+)
+
+// FilterNames is the list of the names which can be used in the filter blocks.
+var FilterNames = []string{
+	"image-id",
+	"name",
+	"description",
+	"platform",
+	"os",
+	"architecture",
+	"state",
+	"owner-id",
+	"owner-alias",
+	"is-public",
+	"shareable",
+	"redistributable",
+}
+
+func filterAttributes(image types.ImagesSet) map[string][]string {
+	return map[string][]string{
+		"image-id":        {nifcloud.ToString(image.ImageId)},
+		"name":            {nifcloud.ToString(image.Name)},
+		"description":     {nifcloud.ToString(image.Description)},
+		"platform":        {nifcloud.ToString(image.Platform)},
+		"os":              {nifcloud.ToString(image.DetailDescription)},
+		"architecture":    {nifcloud.ToString(image.Architecture)},
+		"state":           {nifcloud.ToString(image.ImageState)},
+		"owner-id":        {nifcloud.ToString(image.ImageOwnerId)},
+		"owner-alias":     {nifcloud.ToString(image.ImageOwnerAlias)},
+		"is-public":       {strconv.FormatBool(nifcloud.ToBool(image.IsPublic))},
+		"shareable":       {strconv.FormatBool(nifcloud.ToBool(image.NiftyIsAllowedDistribution))},
+		"redistributable": {strconv.FormatBool(nifcloud.ToBool(image.Redistributable))},
+	}
+}
+
+// FindImages returns the images which match image_name, owner, name_regex and filter of the data source.
+// The images are sorted by the creation date in descending order.
+func FindImages(ctx context.Context, svc *computing.Client, d *schema.ResourceData) ([]types.ImagesSet, error) {
+	input := &computing.DescribeImagesInput{}
+	if v, ok := d.GetOk("image_name"); ok {
+		input.ImageName = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("owner"); ok {
+		input.Owner = []string{v.(string)}
+	}
+
+	res, err := svc.DescribeImages(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterImages(d, res.ImagesSet), nil
+}
+
+// filterImages returns the images which match name_regex and filter of the data source
+// sorted by the creation date in descending order.
+func filterImages(d *schema.ResourceData, all []types.ImagesSet) []types.ImagesSet {
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	filters := filter.Expand(d.Get("filter"))
+
+	images := []types.ImagesSet{}
+	for _, image := range all {
+		if nameRegex != nil && !nameRegex.MatchString(nifcloud.ToString(image.Name)) {
+			continue
+		}
+		if !filter.Match(filters, filterAttributes(image)) {
+			continue
+		}
+		images = append(images, image)
+	}
+
+	sort.SliceStable(images, func(i, j int) bool {
+		return creationDate(images[i]).After(creationDate(images[j]))
+	})
+
+	return images
+}
+
+// selectImage returns the image to be read from the images sorted by FindImages.
+func selectImage(d *schema.ResourceData, images []types.ImagesSet) (types.ImagesSet, error) {
+	if len(images) < 1 {
+		return types.ImagesSet{}, fmt.Errorf("your query returned no results. Please change your search criteria and try again")
+	}
+
+	if len(images) > 1 && !d.Get("most_recent").(bool) {
+		return types.ImagesSet{}, fmt.Errorf("your query returned more than one result. Please try a more specific search criteria, or set `most_recent` attribute to true")
+	}
+
+	return images[0], nil
+}
+
+func creationDate(image types.ImagesSet) time.Time {
+	if image.LaunchTime == nil {
+		return time.Time{}
+	}
+	return *image.LaunchTime
+}
+
+// FormatCreationDate returns the creation date of the image in RFC3339 format.
+func FormatCreationDate(image types.ImagesSet) string {
+	if image.LaunchTime == nil {
+		return ""
+	}
+	return image.LaunchTime.Format(time.RFC3339)
+}
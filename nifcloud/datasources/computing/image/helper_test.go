@@ -0,0 +1,161 @@
+package image
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testImages = []types.ImagesSet{
+	{
+		ImageId:    nifcloud.String("1"),
+		Name:       nifcloud.String("Ubuntu Server 20.04 LTS"),
+		ImageState: nifcloud.String("available"),
+		LaunchTime: nifcloud.Time(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ImageId:    nifcloud.String("2"),
+		Name:       nifcloud.String("Ubuntu Server 22.04 LTS"),
+		ImageState: nifcloud.String("available"),
+		LaunchTime: nifcloud.Time(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		ImageId:    nifcloud.String("3"),
+		Name:       nifcloud.String("Rocky Linux 8"),
+		ImageState: nifcloud.String("available"),
+	},
+	{
+		ImageId:    nifcloud.String("4"),
+		Name:       nifcloud.String("Ubuntu Server 24.04 LTS"),
+		ImageState: nifcloud.String("pending"),
+		LaunchTime: nifcloud.Time(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func imageIDs(images []types.ImagesSet) []string {
+	ids := []string{}
+	for _, image := range images {
+		ids = append(ids, nifcloud.ToString(image.ImageId))
+	}
+	return ids
+}
+
+func TestFilterImages(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []string
+	}{
+		{
+			name: "sorts the images by the creation date and puts the images without it last",
+			args: map[string]interface{}{},
+			want: []string{"4", "2", "1", "3"},
+		},
+		{
+			name: "filters the images by name_regex",
+			args: map[string]interface{}{
+				"name_regex": "^Ubuntu Server 2[02]",
+			},
+			want: []string{"2", "1"},
+		},
+		{
+			name: "filters the images by the name filter with wildcards",
+			args: map[string]interface{}{
+				"filter": []interface{}{
+					map[string]interface{}{
+						"name":   "name",
+						"values": []interface{}{"Ubuntu*", "Rocky Linux ?"},
+					},
+				},
+			},
+			want: []string{"4", "2", "1", "3"},
+		},
+		{
+			name: "combines name_regex and the filters",
+			args: map[string]interface{}{
+				"name_regex": "^Ubuntu",
+				"filter": []interface{}{
+					map[string]interface{}{
+						"name":   "state",
+						"values": []interface{}{"available"},
+					},
+				},
+			},
+			want: []string{"2", "1"},
+		},
+		{
+			name: "returns no images when nothing matches",
+			args: map[string]interface{}{
+				"name_regex": "^CentOS",
+			},
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), tt.args)
+
+			got := filterImages(rd, testImages)
+			assert.Equal(t, tt.want, imageIDs(got))
+		})
+	}
+}
+
+func TestSelectImage(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		images  []types.ImagesSet
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "returns the only image",
+			args:   map[string]interface{}{},
+			images: testImages[:1],
+			want:   "1",
+		},
+		{
+			name:   "returns the most recent image when most_recent is true",
+			args:   map[string]interface{}{"most_recent": true},
+			images: filterImages(schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{}), testImages),
+			want:   "4",
+		},
+		{
+			name:    "returns an error for multiple images when most_recent is false",
+			args:    map[string]interface{}{},
+			images:  testImages,
+			wantErr: true,
+		},
+		{
+			name:    "returns an error for no images",
+			args:    map[string]interface{}{"most_recent": true},
+			images:  []types.ImagesSet{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), tt.args)
+
+			got, err := selectImage(rd, tt.images)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, nifcloud.ToString(got.ImageId))
+		})
+	}
+}
+
+func TestFormatCreationDate(t *testing.T) {
+	assert.Equal(t, "2023-01-01T00:00:00Z", FormatCreationDate(testImages[1]))
+	assert.Equal(t, "", FormatCreationDate(testImages[2]))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/filter"
 )
 
 const description = "Use this data source to get the ID of a image for use in nifcloud_instance resources."
@@ -28,7 +29,8 @@ func newSchema() map[string]*schema.Schema {
 		"image_name": {
 			Type:        schema.TypeString,
 			Description: "The name of image.",
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 		},
 		"owner": {
 			Type:         schema.TypeString,
@@ -36,10 +38,68 @@ func newSchema() map[string]*schema.Schema {
 			Optional:     true,
 			ValidateFunc: validation.StringInSlice([]string{"niftycloud", "self", "other"}, false),
 		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Description:  "A regex string to apply to the image list returned by NIFCLOUD.",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"filter": filter.Schema(FilterNames),
+		"most_recent": {
+			Type:        schema.TypeBool,
+			Description: "If more than one result is returned, use the most recent image.",
+			Optional:    true,
+			Default:     false,
+		},
 		"image_id": {
 			Type:        schema.TypeString,
 			Description: "The id of image.",
 			Computed:    true,
 		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The description of image.",
+			Computed:    true,
+		},
+		"platform": {
+			Type:        schema.TypeString,
+			Description: "The platform of image.",
+			Computed:    true,
+		},
+		"os": {
+			Type:        schema.TypeString,
+			Description: "The detailed description of the OS of image.",
+			Computed:    true,
+		},
+		"architecture": {
+			Type:        schema.TypeString,
+			Description: "The architecture of image.",
+			Computed:    true,
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Description: "The date and time the image was created (RFC3339 format).",
+			Computed:    true,
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "The state of image.",
+			Computed:    true,
+		},
+		"is_public": {
+			Type:        schema.TypeBool,
+			Description: "Whether the image is public.",
+			Computed:    true,
+		},
+		"shareable": {
+			Type:        schema.TypeBool,
+			Description: "Whether the image is allowed to be shared with other users.",
+			Computed:    true,
+		},
+		"redistributable": {
+			Type:        schema.TypeBool,
+			Description: "Whether the image is redistributable.",
+			Computed:    true,
+		},
 	}
 }
@@ -0,0 +1,35 @@
+package images
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, images []types.ImagesSet) error {
+	ids := make([]string, len(images))
+	names := make([]string, len(images))
+	for i, img := range images {
+		idx := i
+		if d.Get("sort_ascending").(bool) {
+			idx = len(images) - 1 - i
+		}
+		ids[idx] = nifcloud.ToString(img.ImageId)
+		names[idx] = nifcloud.ToString(img.Name)
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	return nil
+}
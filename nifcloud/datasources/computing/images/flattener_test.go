@@ -0,0 +1,72 @@
+package images
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	// The images are sorted by the creation date in descending order by image.FindImages.
+	images := []types.ImagesSet{
+		{
+			ImageId: nifcloud.String("3"),
+			Name:    nifcloud.String("newest"),
+		},
+		{
+			ImageId: nifcloud.String("2"),
+			Name:    nifcloud.String("middle"),
+		},
+		{
+			ImageId: nifcloud.String("1"),
+			Name:    nifcloud.String("oldest"),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		args      map[string]interface{}
+		images    []types.ImagesSet
+		wantIDs   []interface{}
+		wantNames []interface{}
+	}{
+		{
+			name:      "returns the most recent image first by default",
+			args:      map[string]interface{}{},
+			images:    images,
+			wantIDs:   []interface{}{"3", "2", "1"},
+			wantNames: []interface{}{"newest", "middle", "oldest"},
+		},
+		{
+			name: "returns the oldest image first when sort_ascending is true",
+			args: map[string]interface{}{
+				"sort_ascending": true,
+			},
+			images:    images,
+			wantIDs:   []interface{}{"1", "2", "3"},
+			wantNames: []interface{}{"oldest", "middle", "newest"},
+		},
+		{
+			name:      "returns empty lists for no images",
+			args:      map[string]interface{}{},
+			images:    []types.ImagesSet{},
+			wantIDs:   []interface{}{},
+			wantNames: []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), tt.args)
+
+			err := flatten(rd, tt.images)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, rd.Id())
+			assert.Equal(t, tt.wantIDs, rd.Get("ids"))
+			assert.Equal(t, tt.wantNames, rd.Get("names"))
+		})
+	}
+}
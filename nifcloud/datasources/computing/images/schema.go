@@ -0,0 +1,67 @@
+package images
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/image"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/filter"
+)
+
+const description = "Use this data source to get the IDs and names of images matching the criteria."
+
+// New returns the nifcloud_images data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"image_name": {
+			Type:        schema.TypeString,
+			Description: "The name of image.",
+			Optional:    true,
+		},
+		"owner": {
+			Type:         schema.TypeString,
+			Description:  "The image owner; valid values: `niftycloud` (standard image) `self` (current account) `other` (other user).",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"niftycloud", "self", "other"}, false),
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Description:  "A regex string to apply to the image list returned by NIFCLOUD.",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"filter": filter.Schema(image.FilterNames),
+		"sort_ascending": {
+			Type:        schema.TypeBool,
+			Description: "Sort the images by the creation date in ascending order. By default, the most recent image comes first.",
+			Optional:    true,
+			Default:     false,
+		},
+		"ids": {
+			Type:        schema.TypeList,
+			Description: "The list of the image IDs.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Description: "The list of the image names.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
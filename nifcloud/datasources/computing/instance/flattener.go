@@ -0,0 +1,93 @@
+package instance
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+)
+
+func flatten(d *schema.ResourceData, instance Instance) error {
+	d.SetId(nifcloud.ToString(instance.InstanceId))
+
+	if err := d.Set("instance_id", instance.InstanceId); err != nil {
+		return err
+	}
+
+	if err := d.Set("accounting_type", instance.NextMonthAccountingType); err != nil {
+		return err
+	}
+
+	if instance.Placement != nil {
+		if err := d.Set("availability_zone", instance.Placement.AvailabilityZone); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("description", instance.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("image_id", instance.ImageId); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_type", instance.InstanceType); err != nil {
+		return err
+	}
+
+	if err := d.Set("key_name", instance.KeyName); err != nil {
+		return err
+	}
+
+	var networkInterfaces []map[string]interface{}
+	for _, n := range instance.NetworkInterfaceSet {
+		ni := map[string]interface{}{
+			"network_id":           nifcloud.ToString(n.NiftyNetworkId),
+			"network_name":         nifcloud.ToString(n.NiftyNetworkName),
+			"network_interface_id": nifcloud.ToString(n.NetworkInterfaceId),
+			"ip_address":           nifcloud.ToString(n.PrivateIpAddress),
+		}
+
+		if n.Attachment != nil {
+			ni["network_interface_attachment_id"] = nifcloud.ToString(n.Attachment.AttachmentId)
+		}
+
+		switch nifcloud.ToString(n.NiftyNetworkId) {
+		case "net-COMMON_GLOBAL":
+			ni["ip_address"] = nifcloud.ToString(instance.IpAddress)
+		case "net-MULTI_IP_ADDRESS":
+			if instance.MultiIpAddressGroup != nil {
+				ni["multi_ip_address_group_id"] = nifcloud.ToString(instance.MultiIpAddressGroup.MultiIpAddressGroupId)
+			}
+		}
+
+		networkInterfaces = append(networkInterfaces, ni)
+	}
+
+	if err := d.Set("network_interface", networkInterfaces); err != nil {
+		return err
+	}
+
+	if err := d.Set("security_group", instance.SecurityGroup); err != nil {
+		return err
+	}
+
+	if instance.InstanceState != nil {
+		if err := d.Set("instance_state", instance.InstanceState.Name); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("private_ip", instance.PrivateIpAddress); err != nil {
+		return err
+	}
+
+	if err := d.Set("public_ip", instance.IpAddress); err != nil {
+		return err
+	}
+
+	if err := d.Set("unique_id", instance.InstanceUniqueId); err != nil {
+		return err
+	}
+
+	return nil
+}
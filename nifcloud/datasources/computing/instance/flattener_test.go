@@ -0,0 +1,97 @@
+package instance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	want := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id":       "test_instance_id",
+		"accounting_type":   "2",
+		"availability_zone": "test_availability_zone",
+		"description":       "test_description",
+		"image_id":          "test_image_id",
+		"instance_type":     "test_instance_type",
+		"key_name":          "test_key_name",
+		"network_interface": []interface{}{
+			map[string]interface{}{
+				"network_id":                      "net-COMMON_GLOBAL",
+				"network_name":                    "",
+				"network_interface_id":            "",
+				"network_interface_attachment_id": "",
+				"ip_address":                      "test_public_ip",
+			},
+			map[string]interface{}{
+				"network_id":                      "test_network_id",
+				"network_name":                    "test_network_name",
+				"network_interface_id":            "test_network_interface_id",
+				"network_interface_attachment_id": "test_attachment_id",
+				"ip_address":                      "test_ip_address",
+			},
+			map[string]interface{}{
+				"network_id":                      "net-MULTI_IP_ADDRESS",
+				"network_name":                    "",
+				"network_interface_id":            "",
+				"network_interface_attachment_id": "",
+				"ip_address":                      "",
+				"multi_ip_address_group_id":       "test_multi_ip_address_group_id",
+			},
+		},
+		"security_group": "test_security_group",
+		"instance_state": "running",
+		"private_ip":     "test_private_ip",
+		"public_ip":      "test_public_ip",
+		"unique_id":      "test_unique_id",
+	})
+	want.SetId("test_instance_id")
+
+	instance := Instance{
+		InstancesSet: types.InstancesSet{
+			InstanceId:              nifcloud.String("test_instance_id"),
+			NextMonthAccountingType: nifcloud.String("2"),
+			Placement: &types.Placement{
+				AvailabilityZone: nifcloud.String("test_availability_zone"),
+			},
+			Description:      nifcloud.String("test_description"),
+			ImageId:          nifcloud.String("test_image_id"),
+			InstanceType:     nifcloud.String("test_instance_type"),
+			KeyName:          nifcloud.String("test_key_name"),
+			InstanceState:    &types.InstanceState{Name: nifcloud.String("running")},
+			PrivateIpAddress: nifcloud.String("test_private_ip"),
+			IpAddress:        nifcloud.String("test_public_ip"),
+			InstanceUniqueId: nifcloud.String("test_unique_id"),
+			NetworkInterfaceSet: []types.NetworkInterfaceSetOfDescribeInstances{
+				{
+					NiftyNetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+				},
+				{
+					NiftyNetworkId:     nifcloud.String("test_network_id"),
+					NiftyNetworkName:   nifcloud.String("test_network_name"),
+					NetworkInterfaceId: nifcloud.String("test_network_interface_id"),
+					PrivateIpAddress:   nifcloud.String("test_ip_address"),
+					Attachment: &types.Attachment{
+						AttachmentId: nifcloud.String("test_attachment_id"),
+					},
+				},
+				{
+					NiftyNetworkId: nifcloud.String("net-MULTI_IP_ADDRESS"),
+				},
+			},
+			MultiIpAddressGroup: &types.MultiIpAddressGroup{
+				MultiIpAddressGroupId: nifcloud.String("test_multi_ip_address_group_id"),
+			},
+		},
+		SecurityGroup: "test_security_group",
+	}
+
+	err := flatten(rd, instance)
+	assert.NoError(t, err)
+	assert.Equal(t, want.State().Attributes, rd.State().Attributes)
+}
@@ -0,0 +1,99 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/filter"
+)
+
+// FilterNames is the list of the names which can be used in the filter blocks.
+var FilterNames = []string{
+	"instance-id",
+	"instance-type",
+	"instance-state",
+	"availability-zone",
+	"security-group",
+	"image-id",
+	"key-name",
+	"description",
+	"unique-id",
+	"private-ip",
+	"public-ip",
+	"network-id",
+	"network-name",
+	"accounting-type",
+}
+
+// Instance is an instance with the security group of its reservation.
+type Instance struct {
+	types.InstancesSet
+
+	SecurityGroup string
+}
+
+func filterAttributes(instance Instance) map[string][]string {
+	attrs := map[string][]string{
+		"instance-id":       {nifcloud.ToString(instance.InstanceId)},
+		"instance-type":     {nifcloud.ToString(instance.InstanceType)},
+		"availability-zone": {},
+		"instance-state":    {},
+		"security-group":    {instance.SecurityGroup},
+		"image-id":          {nifcloud.ToString(instance.ImageId)},
+		"key-name":          {nifcloud.ToString(instance.KeyName)},
+		"description":       {nifcloud.ToString(instance.Description)},
+		"unique-id":         {nifcloud.ToString(instance.InstanceUniqueId)},
+		"private-ip":        {nifcloud.ToString(instance.PrivateIpAddress)},
+		"public-ip":         {nifcloud.ToString(instance.IpAddress)},
+		"accounting-type":   {nifcloud.ToString(instance.NextMonthAccountingType)},
+	}
+
+	if instance.Placement != nil {
+		attrs["availability-zone"] = []string{nifcloud.ToString(instance.Placement.AvailabilityZone)}
+	}
+
+	if instance.InstanceState != nil {
+		attrs["instance-state"] = []string{nifcloud.ToString(instance.InstanceState.Name)}
+	}
+
+	for _, n := range instance.NetworkInterfaceSet {
+		attrs["network-id"] = append(attrs["network-id"], nifcloud.ToString(n.NiftyNetworkId))
+		attrs["network-name"] = append(attrs["network-name"], nifcloud.ToString(n.NiftyNetworkName))
+		attrs["private-ip"] = append(attrs["private-ip"], nifcloud.ToString(n.PrivateIpAddress))
+	}
+
+	return attrs
+}
+
+// FindInstances returns the instances which have one of the instanceIDs and match all the filters.
+// If instanceIDs is empty, all instances are searched.
+func FindInstances(ctx context.Context, svc *computing.Client, instanceIDs []string, filters []filter.Filter) ([]Instance, error) {
+	input := &computing.DescribeInstancesInput{}
+	if len(instanceIDs) > 0 {
+		input.InstanceId = instanceIDs
+	}
+
+	res, err := svc.DescribeInstances(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := []Instance{}
+	for _, r := range res.ReservationSet {
+		securityGroup := ""
+		if len(r.GroupSet) > 0 {
+			securityGroup = nifcloud.ToString(r.GroupSet[0].GroupId)
+		}
+
+		for _, i := range r.InstancesSet {
+			instance := Instance{InstancesSet: i, SecurityGroup: securityGroup}
+			if filter.Match(filters, filterAttributes(instance)) {
+				instances = append(instances, instance)
+			}
+		}
+	}
+
+	return instances, nil
+}
@@ -0,0 +1,39 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/filter"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	var instanceIDs []string
+	if v, ok := d.GetOk("instance_id"); ok {
+		instanceIDs = []string{v.(string)}
+	}
+
+	instances, err := FindInstances(ctx, svc, instanceIDs, filter.Expand(d.Get("filter")))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	if len(instances) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	if len(instances) > 1 {
+		return diag.FromErr(fmt.Errorf("your query returned more than one result. Please try a more specific search criteria"))
+	}
+
+	if err := flatten(d, instances[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package instance
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/filter"
+)
+
+const description = "Use this data source to get information about an existing instance."
+
+// New returns the nifcloud_instance data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The instance name.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"filter": filter.Schema(FilterNames),
+		"accounting_type": {
+			Type:        schema.TypeString,
+			Description: "Accounting type. (1: monthly, 2: pay per use).",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Computed:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The instance description.",
+			Computed:    true,
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Description: "The image name used to start the instance.",
+			Computed:    true,
+		},
+		"instance_type": {
+			Type:        schema.TypeString,
+			Description: "The type of instance.",
+			Computed:    true,
+		},
+		"key_name": {
+			Type:        schema.TypeString,
+			Description: "The key name of the Key Pair to use for the instance.",
+			Computed:    true,
+		},
+		"network_interface": {
+			Type:        schema.TypeList,
+			Description: "The network interfaces attached to the instance.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"network_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the network.",
+						Computed:    true,
+					},
+					"network_name": {
+						Type:        schema.TypeString,
+						Description: "The private lan name.",
+						Computed:    true,
+					},
+					"ip_address": {
+						Type:        schema.TypeString,
+						Description: "The IP address of the network interface.",
+						Computed:    true,
+					},
+					"network_interface_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the network interface.",
+						Computed:    true,
+					},
+					"network_interface_attachment_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the network interface attachment.",
+						Computed:    true,
+					},
+					"multi_ip_address_group_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the multi IP address group associated with the `net-MULTI_IP_ADDRESS` network interface.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"security_group": {
+			Type:        schema.TypeString,
+			Description: "The security group name.",
+			Computed:    true,
+		},
+		"instance_state": {
+			Type:        schema.TypeString,
+			Description: "The state of the instance.",
+			Computed:    true,
+		},
+		"private_ip": {
+			Type:        schema.TypeString,
+			Description: "The private ip address of instance.",
+			Computed:    true,
+		},
+		"public_ip": {
+			Type:        schema.TypeString,
+			Description: "The public ip address of instance.",
+			Computed:    true,
+		},
+		"unique_id": {
+			Type:        schema.TypeString,
+			Description: "The unique ID of instance.",
+			Computed:    true,
+		},
+	}
+}
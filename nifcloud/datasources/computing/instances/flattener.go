@@ -0,0 +1,48 @@
+package instances
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instance"
+)
+
+func flatten(d *schema.ResourceData, instances []instance.Instance) error {
+	sort.Slice(instances, func(i, j int) bool {
+		return nifcloud.ToString(instances[i].InstanceId) < nifcloud.ToString(instances[j].InstanceId)
+	})
+
+	ids := make([]string, len(instances))
+	uniqueIDs := make([]string, len(instances))
+	privateIPs := make([]string, len(instances))
+	publicIPs := make([]string, len(instances))
+	for i, in := range instances {
+		ids[i] = nifcloud.ToString(in.InstanceId)
+		uniqueIDs[i] = nifcloud.ToString(in.InstanceUniqueId)
+		privateIPs[i] = nifcloud.ToString(in.PrivateIpAddress)
+		publicIPs[i] = nifcloud.ToString(in.IpAddress)
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(uniqueIDs, ","))))
+
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+
+	if err := d.Set("unique_ids", uniqueIDs); err != nil {
+		return err
+	}
+
+	if err := d.Set("private_ips", privateIPs); err != nil {
+		return err
+	}
+
+	if err := d.Set("public_ips", publicIPs); err != nil {
+		return err
+	}
+
+	return nil
+}
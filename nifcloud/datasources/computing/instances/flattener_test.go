@@ -0,0 +1,77 @@
+package instances
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instance"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name           string
+		instances      []instance.Instance
+		wantIDs        []interface{}
+		wantUniqueIDs  []interface{}
+		wantPrivateIPs []interface{}
+		wantPublicIPs  []interface{}
+	}{
+		{
+			name: "sorts the instances by ID and keeps the attributes aligned",
+			instances: []instance.Instance{
+				{
+					InstancesSet: types.InstancesSet{
+						InstanceId:       nifcloud.String("web002"),
+						InstanceUniqueId: nifcloud.String("i-002"),
+						PrivateIpAddress: nifcloud.String("192.168.0.2"),
+					},
+				},
+				{
+					InstancesSet: types.InstancesSet{
+						InstanceId:       nifcloud.String("web001"),
+						InstanceUniqueId: nifcloud.String("i-001"),
+						PrivateIpAddress: nifcloud.String("192.168.0.1"),
+						IpAddress:        nifcloud.String("203.0.113.1"),
+					},
+				},
+				{
+					InstancesSet: types.InstancesSet{
+						InstanceId:       nifcloud.String("web003"),
+						InstanceUniqueId: nifcloud.String("i-003"),
+						PrivateIpAddress: nifcloud.String("192.168.0.3"),
+						IpAddress:        nifcloud.String("203.0.113.3"),
+					},
+				},
+			},
+			wantIDs:        []interface{}{"web001", "web002", "web003"},
+			wantUniqueIDs:  []interface{}{"i-001", "i-002", "i-003"},
+			wantPrivateIPs: []interface{}{"192.168.0.1", "192.168.0.2", "192.168.0.3"},
+			wantPublicIPs:  []interface{}{"203.0.113.1", "", "203.0.113.3"},
+		},
+		{
+			name:           "returns empty lists for no instances",
+			instances:      []instance.Instance{},
+			wantIDs:        []interface{}{},
+			wantUniqueIDs:  []interface{}{},
+			wantPrivateIPs: []interface{}{},
+			wantPublicIPs:  []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+			err := flatten(rd, tt.instances)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, rd.Id())
+			assert.Equal(t, tt.wantIDs, rd.Get("ids"))
+			assert.Equal(t, tt.wantUniqueIDs, rd.Get("unique_ids"))
+			assert.Equal(t, tt.wantPrivateIPs, rd.Get("private_ips"))
+			assert.Equal(t, tt.wantPublicIPs, rd.Get("public_ips"))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package instances
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instance"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/filter"
+)
+
+const description = "Use this data source to get the IDs, unique IDs and IP addresses of instances matching the criteria."
+
+// New returns the nifcloud_instances data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"filter": filter.Schema(instance.FilterNames),
+		"ids": {
+			Type:        schema.TypeList,
+			Description: "The list of the instance names sorted in ascending order.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"unique_ids": {
+			Type:        schema.TypeList,
+			Description: "The list of the instance unique IDs in the same order as `ids`.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"private_ips": {
+			Type:        schema.TypeList,
+			Description: "The list of the private ip addresses in the same order as `ids`.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"public_ips": {
+			Type:        schema.TypeList,
+			Description: "The list of the public ip addresses in the same order as `ids`. The element is empty for the instance without a public ip address.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
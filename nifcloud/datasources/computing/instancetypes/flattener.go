@@ -0,0 +1,61 @@
+package instancetypes
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func flatten(d *schema.ResourceData, instanceTypes []instanceType) error {
+	series := d.Get("series").(string)
+	minVCPU := d.Get("min_vcpu").(int)
+	minMemory := d.Get("min_memory").(float64)
+
+	var filtered []instanceType
+	for _, t := range instanceTypes {
+		if series != "" && t.series != series {
+			continue
+		}
+		if t.vcpu < minVCPU || t.memory < minMemory {
+			continue
+		}
+		filtered = append(filtered, t)
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		if filtered[i].vcpu != filtered[j].vcpu {
+			return filtered[i].vcpu < filtered[j].vcpu
+		}
+		if filtered[i].memory != filtered[j].memory {
+			return filtered[i].memory < filtered[j].memory
+		}
+		return filtered[i].name < filtered[j].name
+	})
+
+	names := make([]string, len(filtered))
+	list := make([]map[string]interface{}, len(filtered))
+	for i, t := range filtered {
+		names[i] = t.name
+		list[i] = map[string]interface{}{
+			"name":   t.name,
+			"series": t.series,
+			"size":   t.size,
+			"vcpu":   t.vcpu,
+			"memory": t.memory,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_types", list); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package instancetypes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	instanceTypes := []instanceType{
+		{name: "e2-large16", series: "e2", size: "large", vcpu: 4, memory: 16},
+		{name: "small8", series: "", size: "small", vcpu: 1, memory: 8},
+		{name: "e2-small8", series: "e2", size: "small", vcpu: 1, memory: 8},
+		{name: "e2-medium4", series: "e2", size: "medium", vcpu: 2, memory: 4},
+		{name: "mini", series: "", size: "mini", vcpu: 1, memory: 0.5},
+	}
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []interface{}
+	}{
+		{
+			name: "returns all the instance types",
+			args: map[string]interface{}{},
+			want: []interface{}{"mini", "e2-small8", "small8", "e2-medium4", "e2-large16"},
+		},
+		{
+			name: "filters the instance types by the series",
+			args: map[string]interface{}{
+				"series": "e2",
+			},
+			want: []interface{}{"e2-small8", "e2-medium4", "e2-large16"},
+		},
+		{
+			name: "filters the instance types by the minimum specs",
+			args: map[string]interface{}{
+				"min_vcpu":   2,
+				"min_memory": 8,
+			},
+			want: []interface{}{"e2-large16"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), tt.args)
+
+			err := flatten(rd, instanceTypes)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, rd.Id())
+			assert.Equal(t, tt.want, rd.Get("names"))
+		})
+	}
+}
+
+func TestFlatten_instanceTypes(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"min_memory": 8,
+	})
+
+	err := flatten(rd, []instanceType{
+		{name: "e2-large16", series: "e2", size: "large", vcpu: 4, memory: 16},
+		{name: "e2-small8", series: "e2", size: "small", vcpu: 1, memory: 8},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":   "e2-small8",
+		"series": "e2",
+		"size":   "small",
+		"vcpu":   1,
+		"memory": float64(8),
+	}, rd.Get("instance_types.0"))
+}
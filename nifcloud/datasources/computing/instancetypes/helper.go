@@ -0,0 +1,88 @@
+package instancetypes
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+// NIFCLOUD API does not provide a way to describe the specs of the instance types,
+// so the specs are derived from the instance type names accepted by RunInstances.
+// The name consists of the series prefix, the size and the memory size in GB (e.g. `e2-large16`).
+
+// sizes holds the number of vCPUs of each size and the base memory size in GB,
+// taken from the server type list of the NIFCLOUD service specifications.
+// The base memory size is used when the name has no memory suffix (e.g. `e2-large` has 4 GB of memory).
+// The sizes without the base memory size are always named with the memory suffix.
+var sizes = map[string]struct {
+	vcpu   int
+	memory float64
+}{
+	"mini":         {vcpu: 1, memory: 0.5},
+	"small":        {vcpu: 1, memory: 1},
+	"medium":       {vcpu: 2, memory: 2},
+	"large":        {vcpu: 4, memory: 4},
+	"extra-large":  {vcpu: 8},
+	"double-large": {vcpu: 12},
+	"triple-large": {vcpu: 16},
+	"quad-large":   {vcpu: 24},
+	"septa-large":  {vcpu: 32},
+	"octa-large":   {vcpu: 48},
+}
+
+type instanceType struct {
+	name   string
+	series string
+	size   string
+	vcpu   int
+	memory float64
+}
+
+func listInstanceTypes() []instanceType {
+	var res []instanceType
+	for _, v := range types.InstanceTypeOfRunInstancesRequest("").Values() {
+		if t, ok := parseInstanceType(string(v)); ok {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func parseInstanceType(name string) (instanceType, bool) {
+	series := ""
+	rest := name
+	if i := strings.Index(name, "-"); i >= 0 {
+		if _, ok := sizes[trimMemory(name)]; !ok {
+			series = name[:i]
+			rest = name[i+1:]
+		}
+	}
+
+	size := trimMemory(rest)
+	spec, ok := sizes[size]
+	if !ok {
+		return instanceType{}, false
+	}
+
+	memory := spec.memory
+	if suffix := rest[len(size):]; suffix != "" {
+		m, err := strconv.Atoi(suffix)
+		if err != nil {
+			return instanceType{}, false
+		}
+		memory = float64(m)
+	}
+
+	return instanceType{
+		name:   name,
+		series: series,
+		size:   size,
+		vcpu:   spec.vcpu,
+		memory: memory,
+	}, true
+}
+
+func trimMemory(s string) string {
+	return strings.TrimRight(s, "0123456789")
+}
@@ -0,0 +1,112 @@
+package instancetypes
+
+import (
+	"testing"
+
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstanceType(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   instanceType
+		wantOK bool
+	}{
+		{
+			name: "mini",
+			want: instanceType{
+				name:   "mini",
+				series: "",
+				size:   "mini",
+				vcpu:   1,
+				memory: 0.5,
+			},
+			wantOK: true,
+		},
+		{
+			name: "e2-large",
+			want: instanceType{
+				name:   "e2-large",
+				series: "e2",
+				size:   "large",
+				vcpu:   4,
+				memory: 4,
+			},
+			wantOK: true,
+		},
+		{
+			name: "e2-medium8",
+			want: instanceType{
+				name:   "e2-medium8",
+				series: "e2",
+				size:   "medium",
+				vcpu:   2,
+				memory: 8,
+			},
+			wantOK: true,
+		},
+		{
+			name: "extra-large16",
+			want: instanceType{
+				name:   "extra-large16",
+				series: "",
+				size:   "extra-large",
+				vcpu:   8,
+				memory: 16,
+			},
+			wantOK: true,
+		},
+		{
+			name: "h2r-double-large32",
+			want: instanceType{
+				name:   "h2r-double-large32",
+				series: "h2r",
+				size:   "double-large",
+				vcpu:   12,
+				memory: 32,
+			},
+			wantOK: true,
+		},
+		{
+			name:   "unknown-type",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseInstanceType(tt.name)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestListInstanceTypes(t *testing.T) {
+	got := listInstanceTypes()
+	assert.Len(t, got, len(types.InstanceTypeOfRunInstancesRequest("").Values()))
+
+	names := map[string]bool{}
+	for _, v := range got {
+		names[v.name] = true
+	}
+	assert.True(t, names["mini"])
+	assert.True(t, names["e2-large16"])
+}
+
+func TestListInstanceTypes_specs(t *testing.T) {
+	used := map[string]bool{}
+	for _, v := range listInstanceTypes() {
+		used[v.size] = true
+		assert.Positive(t, v.vcpu, v.name)
+		assert.Positive(t, v.memory, v.name)
+	}
+
+	// Every size in the table is used by the instance types.
+	for size := range sizes {
+		assert.True(t, used[size], "size %q is not used by any instance type", size)
+	}
+}
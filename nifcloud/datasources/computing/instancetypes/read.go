@@ -0,0 +1,16 @@
+package instancetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := flatten(d, listInstanceTypes()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package instancetypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of the instance types with their specs."
+
+// New returns the nifcloud_instance_types data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"series": {
+			Type:        schema.TypeString,
+			Description: "The series of the instance types to filter by. Example: `e2`, `h2`",
+			Optional:    true,
+		},
+		"min_vcpu": {
+			Type:         schema.TypeInt,
+			Description:  "The minimum number of vCPUs of the instance types.",
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"min_memory": {
+			Type:         schema.TypeFloat,
+			Description:  "The minimum memory size (GB) of the instance types.",
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Description: "The list of the instance type names sorted by vCPUs, memory size and name in ascending order.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"instance_types": {
+			Type:        schema.TypeList,
+			Description: "The list of the instance types in the same order as `names`.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the instance type.",
+						Computed:    true,
+					},
+					"series": {
+						Type:        schema.TypeString,
+						Description: "The series of the instance type. Empty for the standard series.",
+						Computed:    true,
+					},
+					"size": {
+						Type:        schema.TypeString,
+						Description: "The size of the instance type. Example: `small`, `double-large`",
+						Computed:    true,
+					},
+					"vcpu": {
+						Type:        schema.TypeInt,
+						Description: "The number of vCPUs.",
+						Computed:    true,
+					},
+					"memory": {
+						Type:        schema.TypeFloat,
+						Description: "The memory size (GB).",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package regions
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeRegionsOutput) error {
+	regions := res.RegionInfo
+
+	sort.Slice(regions, func(i, j int) bool {
+		return nifcloud.ToString(regions[i].RegionName) < nifcloud.ToString(regions[j].RegionName)
+	})
+
+	names := make([]string, len(regions))
+	list := make([]map[string]interface{}, len(regions))
+	for i, r := range regions {
+		names[i] = nifcloud.ToString(r.RegionName)
+		list[i] = map[string]interface{}{
+			"name":       nifcloud.ToString(r.RegionName),
+			"endpoint":   nifcloud.ToString(r.RegionEndpoint),
+			"is_default": nifcloud.ToBool(r.IsDefault),
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	if err := d.Set("regions", list); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package regions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+
+	res := &computing.DescribeRegionsOutput{
+		RegionInfo: []types.RegionInfo{
+			{
+				RegionName:     nifcloud.String("jp-west-1"),
+				RegionEndpoint: nifcloud.String("jp-west-1.computing.api.nifcloud.com"),
+				IsDefault:      nifcloud.Bool(false),
+			},
+			{
+				RegionName:     nifcloud.String("jp-east-1"),
+				RegionEndpoint: nifcloud.String("jp-east-1.computing.api.nifcloud.com"),
+				IsDefault:      nifcloud.Bool(true),
+			},
+		},
+	}
+
+	err := flatten(rd, res)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rd.Id())
+	assert.Equal(t, []interface{}{"jp-east-1", "jp-west-1"}, rd.Get("names"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"name":       "jp-east-1",
+			"endpoint":   "jp-east-1.computing.api.nifcloud.com",
+			"is_default": true,
+		},
+		map[string]interface{}{
+			"name":       "jp-west-1",
+			"endpoint":   "jp-west-1.computing.api.nifcloud.com",
+			"is_default": false,
+		},
+	}, rd.Get("regions"))
+}
@@ -0,0 +1,67 @@
+package securitygroup
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, securityGroup *types.SecurityGroupInfo) error {
+	d.SetId(nifcloud.ToString(securityGroup.GroupName))
+
+	if err := d.Set("group_name", securityGroup.GroupName); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", securityGroup.GroupDescription); err != nil {
+		return err
+	}
+
+	if err := d.Set("availability_zone", securityGroup.AvailabilityZone); err != nil {
+		return err
+	}
+
+	if err := d.Set("log_limit", securityGroup.GroupLogLimit); err != nil {
+		return err
+	}
+
+	if err := d.Set("state", securityGroup.GroupStatus); err != nil {
+		return err
+	}
+
+	var rules []map[string]interface{}
+	for _, p := range securityGroup.IpPermissions {
+		rule := map[string]interface{}{
+			"type":        nifcloud.ToString(p.InOut),
+			"protocol":    nifcloud.ToString(p.IpProtocol),
+			"from_port":   int(nifcloud.ToInt32(p.FromPort)),
+			"to_port":     int(nifcloud.ToInt32(p.ToPort)),
+			"description": nifcloud.ToString(p.Description),
+		}
+
+		if len(p.IpRanges) > 0 {
+			rule["cidr_ip"] = nifcloud.ToString(p.IpRanges[0].CidrIp)
+		}
+
+		if len(p.Groups) > 0 {
+			rule["source_security_group_name"] = nifcloud.ToString(p.Groups[0].GroupName)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := d.Set("rule", rules); err != nil {
+		return err
+	}
+
+	instanceIDs := make([]string, len(securityGroup.InstancesSet))
+	for i, instance := range securityGroup.InstancesSet {
+		instanceIDs[i] = nifcloud.ToString(instance.InstanceId)
+	}
+
+	if err := d.Set("instance_ids", instanceIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package securitygroup
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"group_name": "test_group_name",
+	})
+
+	want := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"group_name":        "test_group_name",
+		"description":       "test_description",
+		"availability_zone": "test_availability_zone",
+		"log_limit":         1000,
+		"state":             "applied",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"type":                       "IN",
+				"protocol":                   "TCP",
+				"from_port":                  22,
+				"to_port":                    0,
+				"cidr_ip":                    "0.0.0.0/0",
+				"source_security_group_name": "",
+				"description":                "test_rule_description",
+			},
+			map[string]interface{}{
+				"type":                       "OUT",
+				"protocol":                   "ANY",
+				"from_port":                  0,
+				"to_port":                    0,
+				"cidr_ip":                    "",
+				"source_security_group_name": "test_source_group_name",
+				"description":                "",
+			},
+		},
+		"instance_ids": []interface{}{"test_instance_id"},
+	})
+	want.SetId("test_group_name")
+
+	securityGroup := &types.SecurityGroupInfo{
+		GroupName:        nifcloud.String("test_group_name"),
+		GroupDescription: nifcloud.String("test_description"),
+		AvailabilityZone: nifcloud.String("test_availability_zone"),
+		GroupLogLimit:    nifcloud.Int32(1000),
+		GroupStatus:      nifcloud.String("applied"),
+		IpPermissions: []types.IpPermissions{
+			{
+				InOut:       nifcloud.String("IN"),
+				IpProtocol:  nifcloud.String("TCP"),
+				FromPort:    nifcloud.Int32(22),
+				Description: nifcloud.String("test_rule_description"),
+				IpRanges:    []types.IpRanges{{CidrIp: nifcloud.String("0.0.0.0/0")}},
+			},
+			{
+				InOut:      nifcloud.String("OUT"),
+				IpProtocol: nifcloud.String("ANY"),
+				Groups:     []types.Groups{{GroupName: nifcloud.String("test_source_group_name")}},
+			},
+		},
+		InstancesSet: []types.InstancesSetOfDescribeSecurityGroups{
+			{InstanceId: nifcloud.String("test_instance_id")},
+		},
+	}
+
+	err := flatten(rd, securityGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, want.State().Attributes, rd.State().Attributes)
+}
@@ -0,0 +1,103 @@
+package securitygroup
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about an existing security group."
+
+// New returns the nifcloud_security_group data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the security group.",
+			Required:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The security group description.",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Computed:    true,
+		},
+		"log_limit": {
+			Type:        schema.TypeInt,
+			Description: "The number of records of the log.",
+			Computed:    true,
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "The state of the security group.",
+			Computed:    true,
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Description: "The list of the ingress and egress rules.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:        schema.TypeString,
+						Description: "The type of rule; `IN` (ingress) or `OUT` (egress).",
+						Computed:    true,
+					},
+					"protocol": {
+						Type:        schema.TypeString,
+						Description: "The protocol.",
+						Computed:    true,
+					},
+					"from_port": {
+						Type:        schema.TypeInt,
+						Description: "The start port.",
+						Computed:    true,
+					},
+					"to_port": {
+						Type:        schema.TypeInt,
+						Description: "The end port.",
+						Computed:    true,
+					},
+					"cidr_ip": {
+						Type:        schema.TypeString,
+						Description: "The CIDR IP Address that allow access.",
+						Computed:    true,
+					},
+					"source_security_group_name": {
+						Type:        schema.TypeString,
+						Description: "The security group name that allow access.",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "The rule description.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"instance_ids": {
+			Type:        schema.TypeList,
+			Description: "The list of the instance names attached to the security group.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
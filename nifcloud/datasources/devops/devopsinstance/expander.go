@@ -0,0 +1,13 @@
+package devopsinstance
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/devops"
+)
+
+func expandGetInstanceInput(d *schema.ResourceData) *devops.GetInstanceInput {
+	return &devops.GetInstanceInput{
+		InstanceId: nifcloud.String(d.Get("instance_id").(string)),
+	}
+}
@@ -0,0 +1,21 @@
+package devopsinstance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/devops"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandGetInstanceInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id": "test_id",
+	})
+
+	got := expandGetInstanceInput(rd)
+	assert.Equal(t, &devops.GetInstanceInput{
+		InstanceId: nifcloud.String("test_id"),
+	}, got)
+}
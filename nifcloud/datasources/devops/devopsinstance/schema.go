@@ -0,0 +1,118 @@
+package devopsinstance
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about an existing DevOps instance."
+
+// New returns the nifcloud_devops_instance data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The name of the DevOps instance.",
+			Required:    true,
+		},
+		"instance_type": {
+			Type:        schema.TypeString,
+			Description: "The instance type of the DevOps instance.",
+			Computed:    true,
+		},
+		"firewall_group_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the DevOps firewall group associated with the instance.",
+			Computed:    true,
+		},
+		"parameter_group_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the DevOps parameter group associated with the instance.",
+			Computed:    true,
+		},
+		"disk_size": {
+			Type:        schema.TypeInt,
+			Description: "The allocated storage in gigabytes.",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone of the DevOps instance.",
+			Computed:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "Description of the DevOps instance.",
+			Computed:    true,
+		},
+		"network_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of private lan.",
+			Computed:    true,
+		},
+		"private_address": {
+			Type:        schema.TypeString,
+			Description: "Private IP address for the DevOps instance.",
+			Computed:    true,
+		},
+		"object_storage_account": {
+			Type:        schema.TypeString,
+			Description: "The account name of the object storage service.",
+			Computed:    true,
+		},
+		"object_storage_region": {
+			Type:        schema.TypeString,
+			Description: "The region where the bucket exists.",
+			Computed:    true,
+		},
+		"lfs_bucket_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket to put LFS objects.",
+			Computed:    true,
+		},
+		"packages_bucket_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket to put packages.",
+			Computed:    true,
+		},
+		"container_registry_bucket_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket to put container registry objects.",
+			Computed:    true,
+		},
+		"to": {
+			Type:        schema.TypeString,
+			Description: "Mail address where alerts are sent.",
+			Computed:    true,
+		},
+		"gitlab_url": {
+			Type:        schema.TypeString,
+			Description: "URL for GitLab.",
+			Computed:    true,
+		},
+		"registry_url": {
+			Type:        schema.TypeString,
+			Description: "URL for GitLab container registry.",
+			Computed:    true,
+		},
+		"public_ip_address": {
+			Type:        schema.TypeString,
+			Description: "Public IP address for the DevOps instance.",
+			Computed:    true,
+		},
+	}
+}
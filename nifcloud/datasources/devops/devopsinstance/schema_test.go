@@ -0,0 +1,91 @@
+package devopsinstance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/devops"
+	"github.com/nifcloud/nifcloud-sdk-go/service/devops/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/devops/devopsinstance"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewSchema_flatten ensures that the schema has all the attributes set by the resource flattener.
+func TestNewSchema_flatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id": "test_id",
+	})
+	rd.SetId("test_id")
+
+	err := devopsinstance.Flatten(rd, &devops.GetInstanceOutput{
+		Instance: &types.Instance{
+			InstanceId:         nifcloud.String("test_id"),
+			InstanceType:       nifcloud.String("c-large"),
+			FirewallGroupName:  nifcloud.String("test_name_fg"),
+			ParameterGroupName: nifcloud.String("test_name_pg"),
+			DiskSize:           nifcloud.Int32(int32(100)),
+			AvailabilityZone:   nifcloud.String("east-11"),
+			Description:        nifcloud.String("test_description"),
+			NetworkConfig: &types.NetworkConfig{
+				NetworkId:      nifcloud.String("test_id_nw"),
+				PrivateAddress: nifcloud.String("192.168.1.1/24"),
+			},
+			ObjectStorageConfig: &types.ObjectStorageConfig{
+				Account: nifcloud.String("test_account"),
+				Region:  nifcloud.String("test_region"),
+				BucketUseObjects: &types.BucketUseObjects{
+					Lfs:               nifcloud.String("test_name_lfs"),
+					Packages:          nifcloud.String("test_name_pkg"),
+					ContainerRegistry: nifcloud.String("test_name_cr"),
+				},
+			},
+			To:              nifcloud.String("test@mail.com"),
+			GitlabUrl:       nifcloud.String("test_url_gl"),
+			RegistryUrl:     nifcloud.String("test_url_cr"),
+			PublicIpAddress: nifcloud.String("198.51.100.1"),
+		},
+	})
+	assert.NoError(t, err)
+
+	want := map[string]interface{}{
+		"gitlab_url":      "test_url_gl",
+		"registry_url":    "test_url_cr",
+		"network_id":      "test_id_nw",
+		"private_address": "192.168.1.1/24",
+		"disk_size":       100,
+	}
+	for k, v := range want {
+		assert.Equal(t, v, rd.Get(k), k)
+	}
+}
+
+func TestNewSchema_flattenWithoutConfigs(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id": "test_id",
+	})
+	rd.SetId("test_id")
+
+	err := devopsinstance.Flatten(rd, &devops.GetInstanceOutput{
+		Instance: &types.Instance{
+			InstanceId:          nifcloud.String("test_id"),
+			InstanceType:        nifcloud.String("c-large"),
+			NetworkConfig:       nil,
+			ObjectStorageConfig: nil,
+			PublicIpAddress:     nifcloud.String("198.51.100.1"),
+		},
+	})
+	assert.NoError(t, err)
+
+	want := map[string]interface{}{
+		"instance_type":          "c-large",
+		"public_ip_address":      "198.51.100.1",
+		"network_id":             "",
+		"private_address":        "",
+		"object_storage_account": "",
+		"lfs_bucket_name":        "",
+	}
+	for k, v := range want {
+		assert.Equal(t, v, rd.Get(k), k)
+	}
+}
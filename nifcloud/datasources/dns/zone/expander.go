@@ -0,0 +1,15 @@
+package zone
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/dns"
+)
+
+func expandGetHostedZoneInput(d *schema.ResourceData) *dns.GetHostedZoneInput {
+	return &dns.GetHostedZoneInput{
+		ZoneID: nifcloud.String(strings.TrimSuffix(d.Get("name").(string), ".")),
+	}
+}
@@ -0,0 +1,43 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandGetHostedZoneInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *dns.GetHostedZoneInput
+	}{
+		{
+			name: "expands the zone name",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"name": "example.com",
+			}),
+			want: &dns.GetHostedZoneInput{
+				ZoneID: nifcloud.String("example.com"),
+			},
+		},
+		{
+			name: "expands the zone name without the trailing dot",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"name": "example.com.",
+			}),
+			want: &dns.GetHostedZoneInput{
+				ZoneID: nifcloud.String("example.com"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandGetHostedZoneInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
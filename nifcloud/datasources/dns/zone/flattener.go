@@ -0,0 +1,46 @@
+package zone
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/dns"
+)
+
+func flatten(d *schema.ResourceData, res *dns.GetHostedZoneOutput) error {
+	if res == nil || res.HostedZone == nil {
+		return fmt.Errorf("hosted zone %q not found", d.Get("name"))
+	}
+
+	hostedZone := res.HostedZone
+
+	// The zone name is used as the zone ID in the DNS API, as the nifcloud_dns_zone resource does.
+	d.SetId(nifcloud.ToString(hostedZone.Name))
+
+	if err := d.Set("zone_id", hostedZone.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", hostedZone.Name); err != nil {
+		return err
+	}
+
+	if hostedZone.Config != nil {
+		if err := d.Set("comment", hostedZone.Config.Comment); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("record_count", hostedZone.ResourceRecordSetCount); err != nil {
+		return err
+	}
+
+	if res.DelegationSet != nil {
+		if err := d.Set("name_servers", res.DelegationSet.NameServers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
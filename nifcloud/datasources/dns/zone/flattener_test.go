@@ -0,0 +1,44 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/dns"
+	"github.com/nifcloud/nifcloud-sdk-go/service/dns/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name": "test_name",
+	})
+
+	want := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":         "test_name",
+		"zone_id":      "test_name",
+		"comment":      "test_comment",
+		"name_servers": []interface{}{"test_server1", "test_server2"},
+		"record_count": 3,
+	})
+	want.SetId("test_name")
+
+	res := &dns.GetHostedZoneOutput{
+		DelegationSet: &types.DelegationSet{
+			NameServers: []string{"test_server1", "test_server2"},
+		},
+		HostedZone: &types.HostedZone{
+			Name:                   nifcloud.String("test_name"),
+			Config:                 &types.Config{Comment: nifcloud.String("test_comment")},
+			ResourceRecordSetCount: nifcloud.Int32(3),
+		},
+	}
+
+	err := flatten(rd, res)
+	assert.NoError(t, err)
+	assert.Equal(t, want.State().Attributes, rd.State().Attributes)
+
+	err = flatten(schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{}), &dns.GetHostedZoneOutput{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package zone
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about an existing DNS hosted zone."
+
+// New returns the nifcloud_dns_zone data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the hosted zone. A trailing dot is ignored.",
+			Required:    true,
+		},
+		"zone_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the hosted zone; which can be used as `zone_id` of the nifcloud_dns_record resource.",
+			Computed:    true,
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Description: "The comment of the hosted zone.",
+			Computed:    true,
+		},
+		"name_servers": {
+			Type:        schema.TypeList,
+			Description: "The list of name servers.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"record_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of the records in the hosted zone.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package privatelan
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandNiftyDescribePrivateLansInput(d *schema.ResourceData) *computing.NiftyDescribePrivateLansInput {
+	input := &computing.NiftyDescribePrivateLansInput{}
+
+	if v, ok := d.GetOk("network_id"); ok {
+		input.NetworkId = []string{v.(string)}
+	}
+
+	if v, ok := d.GetOk("private_lan_name"); ok {
+		input.PrivateLanName = []string{v.(string)}
+	}
+
+	return input
+}
@@ -0,0 +1,49 @@
+package privatelan
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyDescribePrivateLansInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribePrivateLansInput
+	}{
+		{
+			name: "expands the network id",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"network_id": "test_network_id",
+			}),
+			want: &computing.NiftyDescribePrivateLansInput{
+				NetworkId: []string{"test_network_id"},
+			},
+		},
+		{
+			name: "expands the private lan name",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"private_lan_name": "test_private_lan_name",
+			}),
+			want: &computing.NiftyDescribePrivateLansInput{
+				PrivateLanName: []string{"test_private_lan_name"},
+			},
+		},
+		{
+			name: "describes all private lans when only the cidr block is specified",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"cidr_block": "192.168.0.0/24",
+			}),
+			want: &computing.NiftyDescribePrivateLansInput{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribePrivateLansInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package privatelan
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, privateLan *types.PrivateLanSet) error {
+	d.SetId(nifcloud.ToString(privateLan.NetworkId))
+
+	if err := d.Set("network_id", privateLan.NetworkId); err != nil {
+		return err
+	}
+
+	if err := d.Set("private_lan_name", privateLan.PrivateLanName); err != nil {
+		return err
+	}
+
+	if err := d.Set("cidr_block", privateLan.CidrBlock); err != nil {
+		return err
+	}
+
+	if err := d.Set("availability_zone", privateLan.AvailabilityZone); err != nil {
+		return err
+	}
+
+	if err := d.Set("accounting_type", privateLan.NextMonthAccountingType); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", privateLan.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("state", privateLan.State); err != nil {
+		return err
+	}
+
+	routerIDs := make([]string, len(privateLan.RouterSet))
+	for i, r := range privateLan.RouterSet {
+		routerIDs[i] = nifcloud.ToString(r.RouterId)
+	}
+
+	if err := d.Set("router_ids", routerIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package privatelan
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"private_lan_name": "test_private_lan_name",
+	})
+
+	want := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"network_id":        "test_network_id",
+		"private_lan_name":  "test_private_lan_name",
+		"cidr_block":        "192.168.0.0/24",
+		"availability_zone": "test_availability_zone",
+		"accounting_type":   "2",
+		"description":       "test_description",
+		"state":             "available",
+		"router_ids":        []interface{}{"test_router_id"},
+	})
+	want.SetId("test_network_id")
+
+	privateLan := &types.PrivateLanSet{
+		NetworkId:               nifcloud.String("test_network_id"),
+		PrivateLanName:          nifcloud.String("test_private_lan_name"),
+		CidrBlock:               nifcloud.String("192.168.0.0/24"),
+		AvailabilityZone:        nifcloud.String("test_availability_zone"),
+		NextMonthAccountingType: nifcloud.String("2"),
+		Description:             nifcloud.String("test_description"),
+		State:                   nifcloud.String("available"),
+		RouterSet: []types.RouterSetOfNiftyDescribePrivateLans{
+			{RouterId: nifcloud.String("test_router_id")},
+		},
+	}
+
+	err := flatten(rd, privateLan)
+	assert.NoError(t, err)
+	assert.Equal(t, want.State().Attributes, rd.State().Attributes)
+}
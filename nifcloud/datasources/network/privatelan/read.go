@@ -0,0 +1,45 @@
+package privatelan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandNiftyDescribePrivateLansInput(d)
+
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.NiftyDescribePrivateLans(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	var privateLans []types.PrivateLanSet
+	for _, p := range res.PrivateLanSet {
+		if v, ok := d.GetOk("cidr_block"); ok && nifcloud.ToString(p.CidrBlock) != v.(string) {
+			continue
+		}
+		privateLans = append(privateLans, p)
+	}
+
+	if len(privateLans) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	if len(privateLans) > 1 {
+		return diag.FromErr(fmt.Errorf("your query returned more than one result. Please try a more specific search criteria"))
+	}
+
+	if err := flatten(d, &privateLans[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
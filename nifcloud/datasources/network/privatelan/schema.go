@@ -0,0 +1,77 @@
+package privatelan
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
+)
+
+const description = "Use this data source to get information about an existing private lan."
+
+// New returns the nifcloud_private_lan data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_id": {
+			Type:         schema.TypeString,
+			Description:  "The id for the private lan.",
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"network_id", "private_lan_name", "cidr_block"},
+		},
+		"private_lan_name": {
+			Type:        schema.TypeString,
+			Description: "The name for the private lan.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"cidr_block": {
+			Type:        schema.TypeString,
+			Description: "The CIDR IP Address.",
+			Optional:    true,
+			Computed:    true,
+			ValidateDiagFunc: validator.Any(
+				validator.CIDRNetworkAddress,
+			),
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Computed:    true,
+		},
+		"accounting_type": {
+			Type:        schema.TypeString,
+			Description: "Accounting type. (1: monthly, 2: pay per use).",
+			Computed:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The private lan description.",
+			Computed:    true,
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "The state of the private lan.",
+			Computed:    true,
+		},
+		"router_ids": {
+			Type:        schema.TypeList,
+			Description: "The list of the router IDs attached to the private lan.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandNiftyDescribeRoutersInput(d *schema.ResourceData) *computing.NiftyDescribeRoutersInput {
+	input := &computing.NiftyDescribeRoutersInput{}
+
+	if v, ok := d.GetOk("router_id"); ok {
+		input.RouterId = []string{v.(string)}
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.RouterName = []string{v.(string)}
+	}
+
+	return input
+}
@@ -0,0 +1,42 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandNiftyDescribeRoutersInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.NiftyDescribeRoutersInput
+	}{
+		{
+			name: "expands the router id",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"router_id": "test_router_id",
+			}),
+			want: &computing.NiftyDescribeRoutersInput{
+				RouterId: []string{"test_router_id"},
+			},
+		},
+		{
+			name: "expands the router name",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"name": "test_name",
+			}),
+			want: &computing.NiftyDescribeRoutersInput{
+				RouterName: []string{"test_name"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandNiftyDescribeRoutersInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
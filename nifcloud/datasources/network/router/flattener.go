@@ -0,0 +1,92 @@
+package router
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, router *types.RouterSetOfNiftyDescribeRouters) error {
+	d.SetId(nifcloud.ToString(router.RouterId))
+
+	if err := d.Set("router_id", router.RouterId); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", router.RouterName); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", router.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("availability_zone", router.AvailabilityZone); err != nil {
+		return err
+	}
+
+	if err := d.Set("route_table_id", router.RouteTableId); err != nil {
+		return err
+	}
+
+	if err := d.Set("route_table_association_id", router.RouteTableAssociationId); err != nil {
+		return err
+	}
+
+	if err := d.Set("nat_table_id", router.NatTableId); err != nil {
+		return err
+	}
+
+	if err := d.Set("nat_table_association_id", router.NatTableAssociationId); err != nil {
+		return err
+	}
+
+	if len(router.GroupSet) > 0 {
+		if err := d.Set("security_group", router.GroupSet[0].GroupId); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("accounting_type", router.NextMonthAccountingType); err != nil {
+		return err
+	}
+
+	if err := d.Set("state", router.State); err != nil {
+		return err
+	}
+
+	if err := d.Set("type", router.Type); err != nil {
+		return err
+	}
+
+	// sort network interfaces set because API returns unstable set.
+	sort.Slice(router.NetworkInterfaceSet, func(i, j int) bool {
+		return nifcloud.ToString(router.NetworkInterfaceSet[i].NetworkId) < nifcloud.ToString(router.NetworkInterfaceSet[j].NetworkId)
+	})
+
+	var networkInterfaces []map[string]interface{}
+	for _, n := range router.NetworkInterfaceSet {
+		if nifcloud.ToString(n.NetworkId) == "net-COMMON_GLOBAL" {
+			if err := d.Set("public_ip_address", n.IpAddress); err != nil {
+				return err
+			}
+		}
+
+		networkInterfaces = append(networkInterfaces, map[string]interface{}{
+			"network_id":      nifcloud.ToString(n.NetworkId),
+			"network_name":    nifcloud.ToString(n.NetworkName),
+			"ip_address":      nifcloud.ToString(n.IpAddress),
+			"dhcp":            nifcloud.ToBool(n.Dhcp),
+			"dhcp_options_id": nifcloud.ToString(n.DhcpOptionsId),
+			"dhcp_config_id":  nifcloud.ToString(n.DhcpConfigId),
+		})
+	}
+
+	if err := d.Set("network_interface", networkInterfaces); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name": "test_name",
+	})
+
+	want := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"router_id":                  "test_router_id",
+		"name":                       "test_name",
+		"description":                "test_description",
+		"availability_zone":          "test_availability_zone",
+		"accounting_type":            "2",
+		"route_table_id":             "test_route_table_id",
+		"route_table_association_id": "test_route_table_association_id",
+		"nat_table_id":               "test_nat_table_id",
+		"nat_table_association_id":   "test_nat_table_association_id",
+		"security_group":             "test_security_group",
+		"state":                      "available",
+		"type":                       "small",
+		"public_ip_address":          "test_public_ip_address",
+		"network_interface": []interface{}{
+			map[string]interface{}{
+				"network_id":      "net-COMMON_GLOBAL",
+				"network_name":    "",
+				"ip_address":      "test_public_ip_address",
+				"dhcp":            false,
+				"dhcp_options_id": "",
+				"dhcp_config_id":  "",
+			},
+			map[string]interface{}{
+				"network_id":      "net-test_network_id",
+				"network_name":    "test_network_name",
+				"ip_address":      "192.168.0.1",
+				"dhcp":            true,
+				"dhcp_options_id": "test_dhcp_options_id",
+				"dhcp_config_id":  "test_dhcp_config_id",
+			},
+		},
+	})
+	want.SetId("test_router_id")
+
+	router := &types.RouterSetOfNiftyDescribeRouters{
+		RouterId:                nifcloud.String("test_router_id"),
+		RouterName:              nifcloud.String("test_name"),
+		Description:             nifcloud.String("test_description"),
+		AvailabilityZone:        nifcloud.String("test_availability_zone"),
+		NextMonthAccountingType: nifcloud.String("2"),
+		RouteTableId:            nifcloud.String("test_route_table_id"),
+		RouteTableAssociationId: nifcloud.String("test_route_table_association_id"),
+		NatTableId:              nifcloud.String("test_nat_table_id"),
+		NatTableAssociationId:   nifcloud.String("test_nat_table_association_id"),
+		GroupSet: []types.GroupSet{
+			{GroupId: nifcloud.String("test_security_group")},
+		},
+		State: nifcloud.String("available"),
+		Type:  nifcloud.String("small"),
+		NetworkInterfaceSet: []types.NetworkInterfaceSetOfNiftyDescribeRouters{
+			{
+				NetworkId:     nifcloud.String("net-test_network_id"),
+				NetworkName:   nifcloud.String("test_network_name"),
+				IpAddress:     nifcloud.String("192.168.0.1"),
+				Dhcp:          nifcloud.Bool(true),
+				DhcpOptionsId: nifcloud.String("test_dhcp_options_id"),
+				DhcpConfigId:  nifcloud.String("test_dhcp_config_id"),
+			},
+			{
+				NetworkId: nifcloud.String("net-COMMON_GLOBAL"),
+				IpAddress: nifcloud.String("test_public_ip_address"),
+			},
+		},
+	}
+
+	err := flatten(rd, router)
+	assert.NoError(t, err)
+	assert.Equal(t, want.State().Attributes, rd.State().Attributes)
+}
@@ -0,0 +1,35 @@
+package router
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandNiftyDescribeRoutersInput(d)
+
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.NiftyDescribeRouters(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading router: %s", err))
+	}
+
+	if len(res.RouterSet) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	if len(res.RouterSet) > 1 {
+		return diag.FromErr(fmt.Errorf("your query returned more than one result. Please try a more specific search criteria"))
+	}
+
+	if err := flatten(d, &res.RouterSet[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
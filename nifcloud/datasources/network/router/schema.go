@@ -0,0 +1,135 @@
+package router
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about an existing router."
+
+// New returns the nifcloud_router data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"router_id": {
+			Type:         schema.TypeString,
+			Description:  "The unique ID of the router.",
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"router_id", "name"},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The router name.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"accounting_type": {
+			Type:        schema.TypeString,
+			Description: "Accounting type. (1: monthly, 2: pay per use).",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone.",
+			Computed:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The router description.",
+			Computed:    true,
+		},
+		"nat_table_association_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the NAT table association.",
+			Computed:    true,
+		},
+		"nat_table_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the NAT table attached to the router.",
+			Computed:    true,
+		},
+		"network_interface": {
+			Type:        schema.TypeList,
+			Description: "The network interfaces of the router sorted by the network ID.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dhcp": {
+						Type:        schema.TypeBool,
+						Description: "The flag whether DHCP is enabled.",
+						Computed:    true,
+					},
+					"dhcp_config_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the DHCP config attached to the network interface.",
+						Computed:    true,
+					},
+					"dhcp_options_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the DHCP options attached to the network interface.",
+						Computed:    true,
+					},
+					"ip_address": {
+						Type:        schema.TypeString,
+						Description: "The IP address of the network interface.",
+						Computed:    true,
+					},
+					"network_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the network; 'net-COMMON_GLOBAL' or `net-COMMON_PRIVATE` or private lan network id.",
+						Computed:    true,
+					},
+					"network_name": {
+						Type:        schema.TypeString,
+						Description: "The private lan name of the network.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"public_ip_address": {
+			Type:        schema.TypeString,
+			Description: "The public ip address.",
+			Computed:    true,
+		},
+		"route_table_association_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the route table association.",
+			Computed:    true,
+		},
+		"route_table_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the route table attached to the router.",
+			Computed:    true,
+		},
+		"security_group": {
+			Type:        schema.TypeString,
+			Description: "The security group name associated with the router.",
+			Computed:    true,
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "The state of the router.",
+			Computed:    true,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "The type of the router.",
+			Computed:    true,
+		},
+	}
+}
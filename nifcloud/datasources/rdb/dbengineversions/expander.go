@@ -0,0 +1,14 @@
+package dbengineversions
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+)
+
+func expandDescribeDBEngineVersionsInput(d *schema.ResourceData) *rdb.DescribeDBEngineVersionsInput {
+	return &rdb.DescribeDBEngineVersionsInput{
+		Engine:                 types.EngineOfDescribeDBEngineVersionsRequest(d.Get("engine").(string)),
+		DBParameterGroupFamily: types.DBParameterGroupFamilyOfDescribeDBEngineVersionsRequest(d.Get("parameter_group_family").(string)),
+	}
+}
@@ -0,0 +1,25 @@
+package dbengineversions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDescribeDBEngineVersionsInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"engine":                 "MySQL",
+		"version_prefix":         "8.0",
+		"parameter_group_family": "mysql8.0",
+	})
+
+	want := &rdb.DescribeDBEngineVersionsInput{
+		Engine:                 "MySQL",
+		DBParameterGroupFamily: "mysql8.0",
+	}
+
+	got := expandDescribeDBEngineVersionsInput(rd)
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,60 @@
+package dbengineversions
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+)
+
+func flatten(d *schema.ResourceData, versions []types.DBEngineVersions) error {
+	prefix := d.Get("version_prefix").(string)
+
+	var filtered []types.DBEngineVersions
+	for _, v := range versions {
+		if prefix != "" && !strings.HasPrefix(nifcloud.ToString(v.EngineVersion), prefix) {
+			continue
+		}
+		filtered = append(filtered, v)
+	}
+
+	sortVersions(filtered)
+
+	list := make([]map[string]interface{}, len(filtered))
+	ids := make([]string, len(filtered))
+	for i, v := range filtered {
+		list[i] = map[string]interface{}{
+			"engine":                 nifcloud.ToString(v.Engine),
+			"engine_version":         nifcloud.ToString(v.EngineVersion),
+			"parameter_group_family": nifcloud.ToString(v.DBParameterGroupFamily),
+			"description":            nifcloud.ToString(v.DBEngineVersionDescription),
+			"status":                 nifcloud.ToString(v.Status),
+		}
+		ids[i] = nifcloud.ToString(v.Engine) + "-" + nifcloud.ToString(v.EngineVersion)
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+
+	if err := d.Set("versions", list); err != nil {
+		return err
+	}
+
+	latestVersion, latestFamily := "", ""
+	if len(filtered) > 0 {
+		latest := filtered[len(filtered)-1]
+		latestVersion = nifcloud.ToString(latest.EngineVersion)
+		latestFamily = nifcloud.ToString(latest.DBParameterGroupFamily)
+	}
+
+	if err := d.Set("latest_version", latestVersion); err != nil {
+		return err
+	}
+
+	if err := d.Set("latest_parameter_group_family", latestFamily); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package dbengineversions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"engine":         "MySQL",
+		"version_prefix": "8.0",
+	})
+
+	versions := []types.DBEngineVersions{
+		{
+			Engine:                     nifcloud.String("MySQL"),
+			EngineVersion:              nifcloud.String("8.0.35"),
+			DBParameterGroupFamily:     nifcloud.String("mysql8.0"),
+			DBEngineVersionDescription: nifcloud.String("MySQL 8.0.35"),
+			Status:                     nifcloud.String("available"),
+		},
+		{
+			Engine:                 nifcloud.String("MySQL"),
+			EngineVersion:          nifcloud.String("5.7.15"),
+			DBParameterGroupFamily: nifcloud.String("mysql5.7"),
+		},
+		{
+			Engine:                 nifcloud.String("MySQL"),
+			EngineVersion:          nifcloud.String("8.0.9"),
+			DBParameterGroupFamily: nifcloud.String("mysql8.0"),
+		},
+	}
+
+	err := flatten(rd, versions)
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, rd.Id())
+	assert.Equal(t, 2, rd.Get("versions.#"))
+	assert.Equal(t, "8.0.9", rd.Get("versions.0.engine_version"))
+	assert.Equal(t, "8.0.35", rd.Get("versions.1.engine_version"))
+	assert.Equal(t, "mysql8.0", rd.Get("versions.1.parameter_group_family"))
+	assert.Equal(t, "MySQL 8.0.35", rd.Get("versions.1.description"))
+	assert.Equal(t, "available", rd.Get("versions.1.status"))
+	assert.Equal(t, "8.0.35", rd.Get("latest_version"))
+	assert.Equal(t, "mysql8.0", rd.Get("latest_parameter_group_family"))
+}
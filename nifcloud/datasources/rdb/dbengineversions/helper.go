@@ -0,0 +1,53 @@
+package dbengineversions
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+)
+
+// sortVersions sorts the engine versions by the engine and the version in ascending order.
+func sortVersions(versions []types.DBEngineVersions) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		ei, ej := nifcloud.ToString(versions[i].Engine), nifcloud.ToString(versions[j].Engine)
+		if ei != ej {
+			return ei < ej
+		}
+		return compareVersions(nifcloud.ToString(versions[i].EngineVersion), nifcloud.ToString(versions[j].EngineVersion)) < 0
+	})
+}
+
+// compareVersions compares the dot separated versions numerically.
+// The parts which are not numbers are compared as strings.
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case as[i] != bs[i]:
+			return strings.Compare(as[i], bs[i])
+		}
+	}
+
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,26 @@
+package dbengineversions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "8.0.35", b: "8.0.35", want: 0},
+		{a: "8.0.9", b: "8.0.35", want: -1},
+		{a: "8.0.35", b: "5.7.15", want: 1},
+		{a: "13", b: "13.11", want: -1},
+		{a: "14.7", b: "13.11", want: 1},
+		{a: "8.0.a", b: "8.0.b", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, compareVersions(tt.a, tt.b))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package dbengineversions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandDescribeDBEngineVersionsInput(d)
+
+	svc := meta.(*client.Client).RDB
+
+	var versions []types.DBEngineVersions
+	for {
+		res, err := svc.DescribeDBEngineVersions(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+		}
+
+		versions = append(versions, res.DBEngineVersions...)
+
+		if nifcloud.ToString(res.Marker) == "" {
+			break
+		}
+		input.Marker = res.Marker
+	}
+
+	if err := flatten(d, versions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
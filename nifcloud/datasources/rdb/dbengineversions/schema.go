@@ -0,0 +1,89 @@
+package dbengineversions
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of the DB engine versions supported by NIFCLOUD RDB."
+
+// New returns the nifcloud_db_engine_versions data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"engine": {
+			Type:         schema.TypeString,
+			Description:  "The database engine. `MySQL` or `postgres`",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"MySQL", "postgres"}, false),
+		},
+		"version_prefix": {
+			Type:        schema.TypeString,
+			Description: "The prefix of the engine version to filter by. Example: `8.0`",
+			Optional:    true,
+		},
+		"parameter_group_family": {
+			Type:        schema.TypeString,
+			Description: "The DB parameter group family to filter by. Example: `mysql8.0`",
+			Optional:    true,
+		},
+		"versions": {
+			Type:        schema.TypeList,
+			Description: "The list of the engine versions sorted by the engine and the version in ascending order.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"engine": {
+						Type:        schema.TypeString,
+						Description: "The database engine.",
+						Computed:    true,
+					},
+					"engine_version": {
+						Type:        schema.TypeString,
+						Description: "The database engine version.",
+						Computed:    true,
+					},
+					"parameter_group_family": {
+						Type:        schema.TypeString,
+						Description: "The default DB parameter group family of the engine version.",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "The description of the engine version.",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "The status of the engine version.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"latest_version": {
+			Type:        schema.TypeString,
+			Description: "The latest engine version in `versions`. Specify `engine` to get the latest version of the engine.",
+			Computed:    true,
+		},
+		"latest_parameter_group_family": {
+			Type:        schema.TypeString,
+			Description: "The DB parameter group family of `latest_version`.",
+			Computed:    true,
+		},
+	}
+}
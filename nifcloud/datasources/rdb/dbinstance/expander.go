@@ -0,0 +1,13 @@
+package dbinstance
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+)
+
+func expandDescribeDBInstancesInput(d *schema.ResourceData) *rdb.DescribeDBInstancesInput {
+	return &rdb.DescribeDBInstancesInput{
+		DBInstanceIdentifier: nifcloud.String(d.Get("identifier").(string)),
+	}
+}
@@ -0,0 +1,23 @@
+package dbinstance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDescribeDBInstancesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"identifier": "test_identifier",
+	})
+
+	want := &rdb.DescribeDBInstancesInput{
+		DBInstanceIdentifier: nifcloud.String("test_identifier"),
+	}
+
+	got := expandDescribeDBInstancesInput(rd)
+	assert.Equal(t, want, got)
+}
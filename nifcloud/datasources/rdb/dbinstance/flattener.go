@@ -0,0 +1,151 @@
+package dbinstance
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+)
+
+func flatten(d *schema.ResourceData, res *rdb.DescribeDBInstancesOutput) error {
+	if res == nil || len(res.DBInstances) == 0 {
+		return fmt.Errorf("DB instance %q not found", d.Get("identifier"))
+	}
+
+	dbInstance := res.DBInstances[0]
+
+	d.SetId(nifcloud.ToString(dbInstance.DBInstanceIdentifier))
+
+	if err := d.Set("identifier", dbInstance.DBInstanceIdentifier); err != nil {
+		return err
+	}
+
+	if err := d.Set("accounting_type", dbInstance.NextMonthAccountingType); err != nil {
+		return err
+	}
+
+	if err := d.Set("instance_class", dbInstance.DBInstanceClass); err != nil {
+		return err
+	}
+
+	if err := d.Set("db_name", dbInstance.DBName); err != nil {
+		return err
+	}
+
+	if err := d.Set("username", dbInstance.MasterUsername); err != nil {
+		return err
+	}
+
+	if err := d.Set("engine", dbInstance.Engine); err != nil {
+		return err
+	}
+
+	if err := d.Set("engine_version", dbInstance.EngineVersion); err != nil {
+		return err
+	}
+
+	if err := d.Set("ca_cert_identifier", dbInstance.CACertificateIdentifier); err != nil {
+		return err
+	}
+
+	if err := d.Set("allocated_storage", dbInstance.AllocatedStorage); err != nil {
+		return err
+	}
+
+	if err := d.Set("storage_type", dbInstance.NiftyStorageType); err != nil {
+		return err
+	}
+
+	if err := d.Set("availability_zone", dbInstance.AvailabilityZone); err != nil {
+		return err
+	}
+
+	if err := d.Set("backup_retention_period", dbInstance.BackupRetentionPeriod); err != nil {
+		return err
+	}
+
+	if err := d.Set("binlog_retention_period", dbInstance.BinlogRetentionPeriod); err != nil {
+		return err
+	}
+
+	if err := d.Set("backup_window", dbInstance.PreferredBackupWindow); err != nil {
+		return err
+	}
+
+	if err := d.Set("maintenance_window", dbInstance.PreferredMaintenanceWindow); err != nil {
+		return err
+	}
+
+	if err := d.Set("multi_az", dbInstance.MultiAZ); err != nil {
+		return err
+	}
+
+	if err := d.Set("multi_az_type", dbInstance.NiftyMultiAZType); err != nil {
+		return err
+	}
+
+	if err := d.Set("publicly_accessible", dbInstance.PubliclyAccessible); err != nil {
+		return err
+	}
+
+	if len(dbInstance.DBSecurityGroups) > 0 {
+		if err := d.Set("db_security_group_name", dbInstance.DBSecurityGroups[0].DBSecurityGroupName); err != nil {
+			return err
+		}
+	}
+
+	if len(dbInstance.DBParameterGroups) > 0 {
+		if err := d.Set("parameter_group_name", dbInstance.DBParameterGroups[0].DBParameterGroupName); err != nil {
+			return err
+		}
+	}
+
+	if dbInstance.Endpoint != nil {
+		if err := d.Set("address", dbInstance.Endpoint.Address); err != nil {
+			return err
+		}
+
+		if err := d.Set("port", dbInstance.Endpoint.Port); err != nil {
+			return err
+		}
+
+		if err := d.Set("virtual_private_address", dbInstance.Endpoint.NiftyPrivateAddress); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("master_private_address", dbInstance.NiftyMasterPrivateAddress); err != nil {
+		return err
+	}
+
+	if err := d.Set("slave_private_address", dbInstance.NiftySlavePrivateAddress); err != nil {
+		return err
+	}
+
+	// DescribeDBInstances returns the private address of the read replica as the master private address of it.
+	readReplicaPrivateAddress := ""
+	if dbInstance.ReadReplicaSourceDBInstanceIdentifier != nil {
+		readReplicaPrivateAddress = nifcloud.ToString(dbInstance.NiftyMasterPrivateAddress)
+	}
+	if err := d.Set("read_replica_private_address", readReplicaPrivateAddress); err != nil {
+		return err
+	}
+
+	if err := d.Set("network_id", dbInstance.NiftyNetworkId); err != nil {
+		return err
+	}
+
+	if err := d.Set("replicate_source_db", dbInstance.ReadReplicaSourceDBInstanceIdentifier); err != nil {
+		return err
+	}
+
+	if err := d.Set("read_replica_identifiers", dbInstance.ReadReplicaDBInstanceIdentifiers); err != nil {
+		return err
+	}
+
+	if err := d.Set("status", dbInstance.DBInstanceStatus); err != nil {
+		return err
+	}
+	return nil
+}
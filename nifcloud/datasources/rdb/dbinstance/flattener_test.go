@@ -0,0 +1,171 @@
+package dbinstance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"identifier": "test_identifier",
+	})
+
+	want := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"identifier":                   "test_identifier",
+		"accounting_type":              "1",
+		"instance_class":               "test_instance_class",
+		"db_name":                      "test_db_name",
+		"username":                     "test_username",
+		"engine":                       "test_engine",
+		"engine_version":               "test_engine_version",
+		"ca_cert_identifier":           "test_ca_cert_identifier",
+		"allocated_storage":            50,
+		"storage_type":                 1,
+		"availability_zone":            "test_availability_zone",
+		"backup_retention_period":      1,
+		"binlog_retention_period":      2,
+		"backup_window":                "test_backup_window",
+		"maintenance_window":           "test_maintenance_window",
+		"multi_az":                     true,
+		"multi_az_type":                "1",
+		"port":                         3306,
+		"publicly_accessible":          true,
+		"db_security_group_name":       "test_db_security_group_name",
+		"parameter_group_name":         "test_parameter_group_name",
+		"address":                      "test_address",
+		"replicate_source_db":          "test_replicate_source_db",
+		"read_replica_identifiers":     []interface{}{"test_read_replica_identifier"},
+		"network_id":                   "test_network_id",
+		"virtual_private_address":      "test_virtual_private_address",
+		"master_private_address":       "test_master_private_address",
+		"slave_private_address":        "test_slave_private_address",
+		"read_replica_private_address": "test_master_private_address",
+		"status":                       "available",
+	})
+	want.SetId("test_identifier")
+
+	type args struct {
+		res *rdb.DescribeDBInstancesOutput
+		d   *schema.ResourceData
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *schema.ResourceData
+		wantErr bool
+	}{
+		{
+			name: "flattens the response",
+			args: args{
+				d: rd,
+				res: &rdb.DescribeDBInstancesOutput{
+					DBInstances: []types.DBInstances{
+						{
+							DBInstanceIdentifier:       nifcloud.String("test_identifier"),
+							NextMonthAccountingType:    nifcloud.String("1"),
+							DBInstanceClass:            nifcloud.String("test_instance_class"),
+							DBName:                     nifcloud.String("test_db_name"),
+							MasterUsername:             nifcloud.String("test_username"),
+							Engine:                     nifcloud.String("test_engine"),
+							EngineVersion:              nifcloud.String("test_engine_version"),
+							CACertificateIdentifier:    nifcloud.String("test_ca_cert_identifier"),
+							AllocatedStorage:           nifcloud.Int32(50),
+							NiftyStorageType:           nifcloud.Int32(1),
+							AvailabilityZone:           nifcloud.String("test_availability_zone"),
+							BackupRetentionPeriod:      nifcloud.Int32(1),
+							BinlogRetentionPeriod:      nifcloud.Int32(2),
+							PreferredBackupWindow:      nifcloud.String("test_backup_window"),
+							PreferredMaintenanceWindow: nifcloud.String("test_maintenance_window"),
+							MultiAZ:                    nifcloud.Bool(true),
+							NiftyMultiAZType:           nifcloud.String("1"),
+							PubliclyAccessible:         nifcloud.Bool(true),
+							DBSecurityGroups: []types.DBSecurityGroups{
+								{DBSecurityGroupName: nifcloud.String("test_db_security_group_name")},
+							},
+							DBParameterGroups: []types.DBParameterGroups{
+								{DBParameterGroupName: nifcloud.String("test_parameter_group_name")},
+							},
+							Endpoint: &types.Endpoint{
+								Address:             nifcloud.String("test_address"),
+								Port:                nifcloud.Int32(3306),
+								NiftyPrivateAddress: nifcloud.String("test_virtual_private_address"),
+							},
+							NiftyMasterPrivateAddress:             nifcloud.String("test_master_private_address"),
+							NiftySlavePrivateAddress:              nifcloud.String("test_slave_private_address"),
+							NiftyNetworkId:                        nifcloud.String("test_network_id"),
+							ReadReplicaSourceDBInstanceIdentifier: nifcloud.String("test_replicate_source_db"),
+							ReadReplicaDBInstanceIdentifiers:      []string{"test_read_replica_identifier"},
+							DBInstanceStatus:                      nifcloud.String("available"),
+						},
+					},
+				},
+			},
+			want: want,
+		},
+		{
+			name: "returns an error when the DB instance is not found",
+			args: args{
+				d:   schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{"identifier": "test_identifier"}),
+				res: &rdb.DescribeDBInstancesOutput{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := flatten(tt.args.d, tt.args.res)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.State().Attributes, tt.args.d.State().Attributes)
+		})
+	}
+}
+
+func TestFlatten_readReplicaPrivateAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		dbInstance types.DBInstances
+		want       string
+	}{
+		{
+			name: "returns the master private address of the read replica",
+			dbInstance: types.DBInstances{
+				DBInstanceIdentifier:                  nifcloud.String("test_identifier"),
+				NiftyMasterPrivateAddress:             nifcloud.String("192.168.0.2"),
+				ReadReplicaSourceDBInstanceIdentifier: nifcloud.String("test_source"),
+			},
+			want: "192.168.0.2",
+		},
+		{
+			name: "returns empty for the DB instance which is not a read replica",
+			dbInstance: types.DBInstances{
+				DBInstanceIdentifier:      nifcloud.String("test_identifier"),
+				NiftyMasterPrivateAddress: nifcloud.String("192.168.0.1"),
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"identifier": "test_identifier",
+			})
+
+			err := flatten(rd, &rdb.DescribeDBInstancesOutput{
+				DBInstances: []types.DBInstances{tt.dbInstance},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, rd.Get("read_replica_private_address"))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package dbinstance
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about an existing DB instance."
+
+// New returns the nifcloud_db_instance data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"identifier": {
+			Type:        schema.TypeString,
+			Description: "The name of the DB instance.",
+			Required:    true,
+		},
+		"accounting_type": {
+			Type:        schema.TypeString,
+			Description: "Accounting type. (1: monthly, 2: pay per use).",
+			Computed:    true,
+		},
+		"instance_class": {
+			Type:        schema.TypeString,
+			Description: "The instance type of the DB instance.",
+			Computed:    true,
+		},
+		"db_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the database.",
+			Computed:    true,
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Description: "Username for the master DB user.",
+			Computed:    true,
+		},
+		"engine": {
+			Type:        schema.TypeString,
+			Description: "The database engine.",
+			Computed:    true,
+		},
+		"engine_version": {
+			Type:        schema.TypeString,
+			Description: "The database engine version.",
+			Computed:    true,
+		},
+		"ca_cert_identifier": {
+			Type:        schema.TypeString,
+			Description: "The identifier of the CA certificate for the DB instance.",
+			Computed:    true,
+		},
+		"allocated_storage": {
+			Type:        schema.TypeInt,
+			Description: "The allocated storage in gibibytes.",
+			Computed:    true,
+		},
+		"storage_type": {
+			Type:        schema.TypeInt,
+			Description: "One of `0` (High-Speed Storage), `1` (Flash Drive), `2` (Standard Flash Storage), or `3` (High-Speed Flash Storage).",
+			Computed:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The AZ for the DB instance.",
+			Computed:    true,
+		},
+		"backup_retention_period": {
+			Type:        schema.TypeInt,
+			Description: "The days to retain backups for.",
+			Computed:    true,
+		},
+		"binlog_retention_period": {
+			Type:        schema.TypeInt,
+			Description: "The days to retain binlog for.",
+			Computed:    true,
+		},
+		"backup_window": {
+			Type:        schema.TypeString,
+			Description: "The daily time range (in UTC) during which automated backups are created.",
+			Computed:    true,
+		},
+		"maintenance_window": {
+			Type:        schema.TypeString,
+			Description: "The weekly time range (in UTC) the instance maintenance window.",
+			Computed:    true,
+		},
+		"multi_az": {
+			Type:        schema.TypeBool,
+			Description: "If the DB instance is multi AZ enabled.",
+			Computed:    true,
+		},
+		"multi_az_type": {
+			Type:        schema.TypeString,
+			Description: "The type of multi AZ. (0: data priority, 1: performance priority).",
+			Computed:    true,
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "The database port.",
+			Computed:    true,
+		},
+		"publicly_accessible": {
+			Type:        schema.TypeBool,
+			Description: "If the DB instance is publicly accessible.",
+			Computed:    true,
+		},
+		"db_security_group_name": {
+			Type:        schema.TypeString,
+			Description: "The security group name associated with the DB instance.",
+			Computed:    true,
+		},
+		"parameter_group_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the DB parameter group associated with the DB instance.",
+			Computed:    true,
+		},
+		"address": {
+			Type:        schema.TypeString,
+			Description: "The hostname of the DB instance.",
+			Computed:    true,
+		},
+		"replicate_source_db": {
+			Type:        schema.TypeString,
+			Description: "The identifier of the source DB instance if this DB instance is a read replica.",
+			Computed:    true,
+		},
+		"read_replica_identifiers": {
+			Type:        schema.TypeList,
+			Description: "The identifiers of the read replicas of the DB instance.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"network_id": {
+			Type:        schema.TypeString,
+			Description: "The id of private lan.",
+			Computed:    true,
+		},
+		"virtual_private_address": {
+			Type:        schema.TypeString,
+			Description: "Private IP address for virtual load balancer.",
+			Computed:    true,
+		},
+		"master_private_address": {
+			Type:        schema.TypeString,
+			Description: "Private IP address for master DB.",
+			Computed:    true,
+		},
+		"slave_private_address": {
+			Type:        schema.TypeString,
+			Description: "Private IP address for slave DB.",
+			Computed:    true,
+		},
+		"read_replica_private_address": {
+			Type:        schema.TypeString,
+			Description: "Private IP address for read replica. Empty unless the DB instance is a read replica.",
+			Computed:    true,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the DB instance.",
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package dbinstanceclasses
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+)
+
+func expandDescribeOrderableDBInstanceOptionsInput(d *schema.ResourceData) *rdb.DescribeOrderableDBInstanceOptionsInput {
+	input := &rdb.DescribeOrderableDBInstanceOptionsInput{
+		Engine: types.EngineOfDescribeOrderableDBInstanceOptionsRequest(d.Get("engine").(string)),
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = nifcloud.String(v.(string))
+	}
+
+	return input
+}
@@ -0,0 +1,45 @@
+package dbinstanceclasses
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDescribeOrderableDBInstanceOptionsInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *rdb.DescribeOrderableDBInstanceOptionsInput
+	}{
+		{
+			name: "expands the engine",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"engine": "MySQL",
+			}),
+			want: &rdb.DescribeOrderableDBInstanceOptionsInput{
+				Engine: "MySQL",
+			},
+		},
+		{
+			name: "expands the engine and the engine version",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"engine":         "postgres",
+				"engine_version": "13.3",
+			}),
+			want: &rdb.DescribeOrderableDBInstanceOptionsInput{
+				Engine:        "postgres",
+				EngineVersion: nifcloud.String("13.3"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeOrderableDBInstanceOptionsInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
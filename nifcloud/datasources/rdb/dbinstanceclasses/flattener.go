@@ -0,0 +1,52 @@
+package dbinstanceclasses
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+)
+
+func flatten(d *schema.ResourceData, options []types.OrderableDBInstanceOptions) error {
+	zone := d.Get("availability_zone").(string)
+	multiAZCapable := d.Get("multi_az_capable").(bool)
+
+	seen := map[string]struct{}{}
+	for _, o := range options {
+		if multiAZCapable && !nifcloud.ToBool(o.MultiAZCapable) {
+			continue
+		}
+
+		if zone != "" && !hasAvailabilityZone(o, zone) {
+			continue
+		}
+
+		seen[nifcloud.ToString(o.DBInstanceClass)] = struct{}{}
+	}
+
+	classes := make([]string, 0, len(seen))
+	for c := range seen {
+		classes = append(classes, c)
+	}
+	sort.Strings(classes)
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(classes, ","))))
+
+	if err := d.Set("instance_classes", classes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func hasAvailabilityZone(option types.OrderableDBInstanceOptions, zone string) bool {
+	for _, z := range option.AvailabilityZones {
+		if nifcloud.ToString(z.Name) == zone {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package dbinstanceclasses
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	options := []types.OrderableDBInstanceOptions{
+		{
+			DBInstanceClass:   nifcloud.String("db.mini"),
+			MultiAZCapable:    nifcloud.Bool(false),
+			AvailabilityZones: []types.AvailabilityZones{{Name: nifcloud.String("east-11")}},
+		},
+		{
+			DBInstanceClass:   nifcloud.String("db.large"),
+			MultiAZCapable:    nifcloud.Bool(true),
+			AvailabilityZones: []types.AvailabilityZones{{Name: nifcloud.String("east-11")}, {Name: nifcloud.String("east-21")}},
+		},
+		{
+			DBInstanceClass:   nifcloud.String("db.large"),
+			MultiAZCapable:    nifcloud.Bool(true),
+			AvailabilityZones: []types.AvailabilityZones{{Name: nifcloud.String("east-11")}},
+		},
+		{
+			DBInstanceClass:   nifcloud.String("db.small"),
+			MultiAZCapable:    nifcloud.Bool(true),
+			AvailabilityZones: []types.AvailabilityZones{{Name: nifcloud.String("east-11")}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []interface{}
+	}{
+		{
+			name: "returns all the instance classes",
+			args: map[string]interface{}{
+				"engine": "MySQL",
+			},
+			want: []interface{}{"db.large", "db.mini", "db.small"},
+		},
+		{
+			name: "filters the instance classes by the availability zone",
+			args: map[string]interface{}{
+				"engine":            "MySQL",
+				"availability_zone": "east-21",
+			},
+			want: []interface{}{"db.large"},
+		},
+		{
+			name: "filters the instance classes by multi AZ capability",
+			args: map[string]interface{}{
+				"engine":           "MySQL",
+				"multi_az_capable": true,
+			},
+			want: []interface{}{"db.large", "db.small"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), tt.args)
+
+			err := flatten(rd, options)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, rd.Id())
+			assert.Equal(t, tt.want, rd.Get("instance_classes"))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package dbinstanceclasses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/rdb/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandDescribeOrderableDBInstanceOptionsInput(d)
+
+	svc := meta.(*client.Client).RDB
+
+	var options []types.OrderableDBInstanceOptions
+	for {
+		res, err := svc.DescribeOrderableDBInstanceOptions(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+		}
+
+		options = append(options, res.OrderableDBInstanceOptions...)
+
+		if nifcloud.ToString(res.Marker) == "" {
+			break
+		}
+		input.Marker = res.Marker
+	}
+
+	if err := flatten(d, options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
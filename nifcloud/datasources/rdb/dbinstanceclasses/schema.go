@@ -0,0 +1,56 @@
+package dbinstanceclasses
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of the DB instance classes available for an engine and an availability zone."
+
+// New returns the nifcloud_db_instance_classes data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"engine": {
+			Type:         schema.TypeString,
+			Description:  "The database engine. `MySQL` or `postgres`",
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"MySQL", "postgres"}, false),
+		},
+		"engine_version": {
+			Type:        schema.TypeString,
+			Description: "The database engine version.",
+			Optional:    true,
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Description: "The availability zone where the instance classes are available.",
+			Optional:    true,
+		},
+		"multi_az_capable": {
+			Type:        schema.TypeBool,
+			Description: "If true, only the instance classes which support multi AZ are returned.",
+			Optional:    true,
+		},
+		"instance_classes": {
+			Type:        schema.TypeList,
+			Description: "The list of the DB instance classes sorted in ascending order.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
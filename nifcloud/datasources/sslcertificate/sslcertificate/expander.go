@@ -0,0 +1,15 @@
+package sslcertificate
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func expandDescribeSslCertificatesInput(d *schema.ResourceData) *computing.DescribeSslCertificatesInput {
+	input := &computing.DescribeSslCertificatesInput{}
+
+	if v, ok := d.GetOk("fqdn"); ok {
+		input.Fqdn = []string{v.(string)}
+	}
+	return input
+}
@@ -0,0 +1,40 @@
+package sslcertificate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDescribeSslCertificatesInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.DescribeSslCertificatesInput
+	}{
+		{
+			name: "expands the fqdn",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"fqdn": "www.example.com",
+			}),
+			want: &computing.DescribeSslCertificatesInput{
+				Fqdn: []string{"www.example.com"},
+			},
+		},
+		{
+			name: "describes all certificates when the fqdn is not specified",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"description": "test_description",
+			}),
+			want: &computing.DescribeSslCertificatesInput{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandDescribeSslCertificatesInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package sslcertificate
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+func flatten(d *schema.ResourceData, cert *types.CertsSet) error {
+	d.SetId(nifcloud.ToString(cert.FqdnId))
+
+	if err := d.Set("fqdn_id", cert.FqdnId); err != nil {
+		return err
+	}
+
+	if err := d.Set("fqdn", cert.Fqdn); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", cert.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("issuer", cert.CertAuthority); err != nil {
+		return err
+	}
+
+	if err := d.Set("key_length", cert.KeyLength); err != nil {
+		return err
+	}
+
+	if cert.Period == nil {
+		return nil
+	}
+
+	if err := d.Set("start_date", formatDate(cert.Period.StartDate)); err != nil {
+		return err
+	}
+
+	if err := d.Set("end_date", formatDate(cert.Period.EndDate)); err != nil {
+		return err
+	}
+
+	if err := d.Set("validity_term", cert.Period.ValidityTerm); err != nil {
+		return err
+	}
+
+	return nil
+}
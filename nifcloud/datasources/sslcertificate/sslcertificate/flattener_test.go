@@ -0,0 +1,51 @@
+package sslcertificate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"fqdn": "www.example.com",
+	})
+
+	cert := &types.CertsSet{
+		FqdnId:        nifcloud.String("test_fqdn_id"),
+		Fqdn:          nifcloud.String("www.example.com"),
+		Description:   nifcloud.String("test_description"),
+		CertAuthority: nifcloud.String("test_issuer"),
+		KeyLength:     nifcloud.Int32(2048),
+		Period: &types.Period{
+			StartDate:    &startDate,
+			EndDate:      &endDate,
+			ValidityTerm: nifcloud.Int32(12),
+		},
+	}
+
+	err := flatten(rd, cert)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "test_fqdn_id", rd.Id())
+	want := map[string]interface{}{
+		"fqdn_id":       "test_fqdn_id",
+		"fqdn":          "www.example.com",
+		"description":   "test_description",
+		"issuer":        "test_issuer",
+		"key_length":    2048,
+		"start_date":    "2024-01-01T00:00:00Z",
+		"end_date":      "2025-01-01T00:00:00Z",
+		"validity_term": 12,
+	}
+	for k, v := range want {
+		assert.Equal(t, v, rd.Get(k), k)
+	}
+}
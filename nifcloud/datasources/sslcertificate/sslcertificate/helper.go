@@ -0,0 +1,29 @@
+package sslcertificate
+
+import (
+	"sort"
+	"time"
+
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+// sortByEndDate sorts the certificates so that the one with the latest expiry comes first.
+func sortByEndDate(certs []types.CertsSet) {
+	sort.SliceStable(certs, func(i, j int) bool {
+		return endDate(certs[i]).After(endDate(certs[j]))
+	})
+}
+
+func endDate(cert types.CertsSet) time.Time {
+	if cert.Period == nil || cert.Period.EndDate == nil {
+		return time.Time{}
+	}
+	return *cert.Period.EndDate
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,29 @@
+package sslcertificate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortByEndDate(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	certs := []types.CertsSet{
+		{FqdnId: nifcloud.String("no_period")},
+		{FqdnId: nifcloud.String("older"), Period: &types.Period{EndDate: &older}},
+		{FqdnId: nifcloud.String("newer"), Period: &types.Period{EndDate: &newer}},
+	}
+
+	sortByEndDate(certs)
+
+	var got []string
+	for _, c := range certs {
+		got = append(got, nifcloud.ToString(c.FqdnId))
+	}
+	assert.Equal(t, []string{"newer", "older", "no_period"}, got)
+}
@@ -0,0 +1,43 @@
+package sslcertificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandDescribeSslCertificatesInput(d)
+
+	svc := meta.(*client.Client).Computing
+
+	res, err := svc.DescribeSslCertificates(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+	}
+
+	var certs []types.CertsSet
+	for _, c := range res.CertsSet {
+		if v, ok := d.GetOk("description"); ok && nifcloud.ToString(c.Description) != v.(string) {
+			continue
+		}
+		certs = append(certs, c)
+	}
+
+	if len(certs) < 1 {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	sortByEndDate(certs)
+
+	if err := flatten(d, &certs[0]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
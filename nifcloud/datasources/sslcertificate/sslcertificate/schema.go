@@ -0,0 +1,71 @@
+package sslcertificate
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Use this data source to get information about an existing ssl certificate."
+
+// New returns the nifcloud_ssl_certificate data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"fqdn": {
+			Type:         schema.TypeString,
+			Description:  "The FQDN of the certificate.",
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"fqdn", "description"},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The SSL certificate description.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"fqdn_id": {
+			Type:        schema.TypeString,
+			Description: "The unique identifier for the certificate.",
+			Computed:    true,
+		},
+		"issuer": {
+			Type:        schema.TypeString,
+			Description: "The certificate authority which issued the certificate.",
+			Computed:    true,
+		},
+		"key_length": {
+			Type:        schema.TypeInt,
+			Description: "The key length of the certificate.",
+			Computed:    true,
+		},
+		"start_date": {
+			Type:        schema.TypeString,
+			Description: "The start date of the validity period in RFC3339 format.",
+			Computed:    true,
+		},
+		"end_date": {
+			Type:        schema.TypeString,
+			Description: "The end date of the validity period in RFC3339 format.",
+			Computed:    true,
+		},
+		"validity_term": {
+			Type:        schema.TypeInt,
+			Description: "The validity term of the certificate.",
+			Computed:    true,
+		},
+	}
+}
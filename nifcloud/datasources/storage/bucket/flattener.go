@@ -0,0 +1,63 @@
+package bucket
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/storage/bucket"
+)
+
+type describeOutputs struct {
+	bucket        *types.Buckets
+	versioningRes *storage.GetBucketVersioningOutput
+	policyRes     *storage.GetBucketPolicyOutput
+	region        string
+	endpoint      string
+}
+
+func flatten(d *schema.ResourceData, res *describeOutputs) error {
+	name := nifcloud.ToString(res.bucket.Name)
+	d.SetId(name)
+
+	if err := d.Set("bucket", name); err != nil {
+		return err
+	}
+
+	if err := d.Set("region", res.region); err != nil {
+		return err
+	}
+
+	endpoint := ""
+	if res.endpoint != "" {
+		endpoint = strings.TrimSuffix(res.endpoint, "/") + "/" + name
+	}
+	if err := d.Set("endpoint", endpoint); err != nil {
+		return err
+	}
+
+	creationDate := ""
+	if res.bucket.CreationDate != nil {
+		creationDate = res.bucket.CreationDate.Format(time.RFC3339)
+	}
+	if err := d.Set("creation_date", creationDate); err != nil {
+		return err
+	}
+
+	if err := d.Set("versioning", bucket.FlattenVersioning(res.versioningRes)); err != nil {
+		return err
+	}
+
+	policy := ""
+	if res.policyRes != nil {
+		policy = nifcloud.ToString(res.policyRes.Policy)
+	}
+	if err := d.Set("policy", policy); err != nil {
+		return err
+	}
+
+	return nil
+}
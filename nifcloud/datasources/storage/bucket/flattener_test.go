@@ -0,0 +1,80 @@
+package bucket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	creationDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	bucket := &types.Buckets{
+		Name:         nifcloud.String("test-bucket"),
+		CreationDate: &creationDate,
+	}
+
+	tests := []struct {
+		name string
+		args *describeOutputs
+		want map[string]interface{}
+	}{
+		{
+			name: "flattens the bucket",
+			args: &describeOutputs{
+				bucket: bucket,
+				versioningRes: &storage.GetBucketVersioningOutput{
+					Status: nifcloud.String("Enabled"),
+				},
+				policyRes: &storage.GetBucketPolicyOutput{
+					Policy: nifcloud.String(`{"Statement":[]}`),
+				},
+				region:   "jp-east-1",
+				endpoint: "https://jp-east-1.storage.api.nifcloud.com",
+			},
+			want: map[string]interface{}{
+				"bucket":               "test-bucket",
+				"region":               "jp-east-1",
+				"endpoint":             "https://jp-east-1.storage.api.nifcloud.com/test-bucket",
+				"creation_date":        "2024-01-01T00:00:00Z",
+				"versioning.0.enabled": true,
+				"policy":               `{"Statement":[]}`,
+			},
+		},
+		{
+			name: "flattens the bucket without versioning and policy",
+			args: &describeOutputs{
+				bucket:        bucket,
+				versioningRes: &storage.GetBucketVersioningOutput{},
+				region:        "jp-east-1",
+			},
+			want: map[string]interface{}{
+				"bucket":               "test-bucket",
+				"region":               "jp-east-1",
+				"endpoint":             "",
+				"creation_date":        "2024-01-01T00:00:00Z",
+				"versioning.0.enabled": false,
+				"policy":               "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"bucket": "test-bucket",
+			})
+
+			err := flatten(rd, tt.args)
+			assert.NoError(t, err)
+			assert.Equal(t, "test-bucket", rd.Id())
+			for k, v := range tt.want {
+				assert.Equal(t, v, rd.Get(k), k)
+			}
+		})
+	}
+}
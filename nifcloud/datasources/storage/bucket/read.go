@@ -0,0 +1,56 @@
+package bucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/storage/bucket"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*client.Client)
+	svc := c.Storage
+
+	res, err := svc.GetService(ctx, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading buckets: %s", err))
+	}
+
+	name := d.Get("bucket").(string)
+	b, found := bucket.FindBucket(res.Buckets, name)
+	if !found {
+		return diag.FromErr(fmt.Errorf("your query returned no results. Please change your search criteria and try again"))
+	}
+
+	versioningRes, err := svc.GetBucketVersioning(ctx, bucket.ExpandGetBucketVersioningInput(name))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed reading bucket versioning: %s", err))
+	}
+
+	policyRes, err := svc.GetBucketPolicy(ctx, bucket.ExpandGetBucketPolicyInput(name))
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "NoSuchBucketPolicy" {
+			policyRes = nil
+		} else {
+			return diag.FromErr(fmt.Errorf("failed reading bucket policy: %s", err))
+		}
+	}
+
+	if err := flatten(d, &describeOutputs{
+		bucket:        &b,
+		versioningRes: versioningRes,
+		policyRes:     policyRes,
+		region:        c.StorageRegion,
+		endpoint:      c.StorageEndpoint,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
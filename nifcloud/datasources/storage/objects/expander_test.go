@@ -0,0 +1,50 @@
+package objects
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandGetBucketInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   *schema.ResourceData
+		marker string
+		want   *storage.GetBucketInput
+	}{
+		{
+			name: "expands the bucket and the prefix",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"bucket": "test-bucket",
+				"prefix": "artifacts/",
+			}),
+			want: &storage.GetBucketInput{
+				Bucket:  nifcloud.String("test-bucket"),
+				Prefix:  nifcloud.String("artifacts/"),
+				MaxKeys: nifcloud.String("1000"),
+			},
+		},
+		{
+			name: "expands the marker",
+			args: schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+				"bucket": "test-bucket",
+			}),
+			marker: "artifacts/app-1.0.0.zip",
+			want: &storage.GetBucketInput{
+				Bucket:  nifcloud.String("test-bucket"),
+				Marker:  nifcloud.String("artifacts/app-1.0.0.zip"),
+				MaxKeys: nifcloud.String("1000"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandGetBucketInput(tt.args, tt.marker)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
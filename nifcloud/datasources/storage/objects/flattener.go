@@ -0,0 +1,77 @@
+package objects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+)
+
+// flatten sets the first max_keys objects and the latest object of all the contents.
+func flatten(d *schema.ResourceData, contents []types.Contents) error {
+	var latest *types.Contents
+	for i, c := range contents {
+		if c.LastModified == nil {
+			continue
+		}
+		if latest == nil || c.LastModified.After(*latest.LastModified) {
+			latest = &contents[i]
+		}
+	}
+
+	if maxKeys := d.Get("max_keys").(int); len(contents) > maxKeys {
+		contents = contents[:maxKeys]
+	}
+
+	keys := make([]string, len(contents))
+	objects := make([]map[string]interface{}, len(contents))
+	for i, c := range contents {
+		size := 0
+		if v := nifcloud.ToString(c.Size); v != "" {
+			s, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("failed to parse the size of the object %q: %s", nifcloud.ToString(c.Key), err)
+			}
+			size = s
+		}
+
+		lastModified := ""
+		if c.LastModified != nil {
+			lastModified = c.LastModified.Format(time.RFC3339)
+		}
+
+		keys[i] = nifcloud.ToString(c.Key)
+		objects[i] = map[string]interface{}{
+			"key":           nifcloud.ToString(c.Key),
+			"size":          size,
+			"etag":          nifcloud.ToString(c.ETag),
+			"last_modified": lastModified,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(
+		fmt.Sprintf("%s/%s:%s", d.Get("bucket").(string), d.Get("prefix").(string), strings.Join(keys, ",")),
+	)))
+
+	if err := d.Set("keys", keys); err != nil {
+		return err
+	}
+
+	latestKey := ""
+	if latest != nil {
+		latestKey = nifcloud.ToString(latest.Key)
+	}
+	if err := d.Set("latest_key", latestKey); err != nil {
+		return err
+	}
+
+	if err := d.Set("objects", objects); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package objects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"bucket": "test-bucket",
+		"prefix": "artifacts/",
+	})
+
+	err := flatten(rd, []types.Contents{
+		{
+			Key:          nifcloud.String("artifacts/app-1.0.0.zip"),
+			Size:         nifcloud.String("1024"),
+			ETag:         nifcloud.String(`"etag1"`),
+			LastModified: &older,
+		},
+		{
+			Key:          nifcloud.String("artifacts/app-1.1.0.zip"),
+			Size:         nifcloud.String("2048"),
+			ETag:         nifcloud.String(`"etag2"`),
+			LastModified: &newer,
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rd.Id())
+	assert.Equal(t, []interface{}{"artifacts/app-1.0.0.zip", "artifacts/app-1.1.0.zip"}, rd.Get("keys"))
+	assert.Equal(t, "artifacts/app-1.1.0.zip", rd.Get("latest_key"))
+	assert.Equal(t, map[string]interface{}{
+		"key":           "artifacts/app-1.0.0.zip",
+		"size":          1024,
+		"etag":          `"etag1"`,
+		"last_modified": "2024-01-01T00:00:00Z",
+	}, rd.Get("objects.0"))
+}
+
+func TestFlatten_empty(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"bucket": "test-bucket",
+	})
+
+	err := flatten(rd, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rd.Id())
+	assert.Equal(t, []interface{}{}, rd.Get("keys"))
+	assert.Equal(t, "", rd.Get("latest_key"))
+}
+
+func TestFlatten_invalidSize(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"bucket": "test-bucket",
+	})
+
+	err := flatten(rd, []types.Contents{
+		{
+			Key:  nifcloud.String("test"),
+			Size: nifcloud.String("invalid"),
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestFlatten_maxKeys(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"bucket":   "test-bucket",
+		"max_keys": 1,
+	})
+
+	err := flatten(rd, []types.Contents{
+		{
+			Key:          nifcloud.String("a.zip"),
+			LastModified: &older,
+		},
+		{
+			Key:          nifcloud.String("b.zip"),
+			LastModified: &newer,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a.zip"}, rd.Get("keys"))
+	assert.Len(t, rd.Get("objects"), 1)
+	assert.Equal(t, "b.zip", rd.Get("latest_key"))
+}
@@ -0,0 +1,44 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Storage
+
+	// All the objects under the prefix are listed to find the latest object,
+	// because GetBucket returns the objects in the key order.
+	var contents []types.Contents
+	marker := ""
+	for {
+		res, err := svc.GetBucket(ctx, expandGetBucketInput(d, marker))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed reading: %s", err))
+		}
+
+		contents = append(contents, res.Contents...)
+
+		if !nifcloud.ToBool(res.IsTruncated) || len(res.Contents) == 0 {
+			break
+		}
+
+		marker = nifcloud.ToString(res.NextMarker)
+		if marker == "" {
+			marker = nifcloud.ToString(res.Contents[len(res.Contents)-1].Key)
+		}
+	}
+
+	if err := flatten(d, contents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package objects
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const description = "Use this data source to get the list of the objects in a storage bucket."
+
+// New returns the nifcloud_storage_objects data source schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		ReadContext: read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket.",
+			Required:    true,
+		},
+		"prefix": {
+			Type:        schema.TypeString,
+			Description: "Limits the results to the object keys that begin with the prefix.",
+			Optional:    true,
+		},
+		"max_keys": {
+			Type:         schema.TypeInt,
+			Description:  "The maximum number of the object keys to return in `keys` and `objects`.",
+			Optional:     true,
+			Default:      1000,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"keys": {
+			Type:        schema.TypeList,
+			Description: "The list of the object keys in ascending order.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"latest_key": {
+			Type:        schema.TypeString,
+			Description: "The key of the most recently modified object under the prefix. All the objects are searched regardless of `max_keys`.",
+			Computed:    true,
+		},
+		"objects": {
+			Type:        schema.TypeList,
+			Description: "The list of the objects in the same order as `keys`.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Description: "The key of the object.",
+						Computed:    true,
+					},
+					"size": {
+						Type:        schema.TypeInt,
+						Description: "The size of the object in bytes.",
+						Computed:    true,
+					},
+					"etag": {
+						Type:        schema.TypeString,
+						Description: "The ETag of the object.",
+						Computed:    true,
+					},
+					"last_modified": {
+						Type:        schema.TypeString,
+						Description: "The last modified date of the object in RFC3339 format.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Filter is a filter block of the data sources.
+type Filter struct {
+	Name   string
+	Values []string
+}
+
+// Schema returns the schema of the filter blocks which accept the given filter names.
+func Schema(names []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "One or more name/value pairs to filter off of.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Description:  "The name of the field to filter by.",
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(names, false),
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Description: "The values of the field to filter by. The wildcards `*` and `?` are supported.",
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// Expand returns the filters from the value of the filter blocks.
+func Expand(v interface{}) []Filter {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	filters := []Filter{}
+	for _, f := range set.List() {
+		m := f.(map[string]interface{})
+		filter := Filter{Name: m["name"].(string)}
+		for _, value := range m["values"].([]interface{}) {
+			filter.Values = append(filter.Values, value.(string))
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// Match reports whether the attributes match all the filters.
+// A filter matches when one of its values matches one of the attribute values.
+func Match(filters []Filter, attrs map[string][]string) bool {
+	for _, f := range filters {
+		if !matchAny(f.Values, attrs[f.Name]) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAny(patterns []string, values []string) bool {
+	for _, p := range patterns {
+		re := wildcardToRegexp(p)
+		for _, v := range values {
+			if re.MatchString(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wildcardToRegexp converts the pattern with the wildcards `*` and `?` to regexp.
+func wildcardToRegexp(pattern string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(pattern)
+	quoted = strings.ReplaceAll(quoted, `\*`, ".*")
+	quoted = strings.ReplaceAll(quoted, `\?`, ".")
+	return regexp.MustCompile("^" + quoted + "$")
+}
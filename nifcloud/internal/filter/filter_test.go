@@ -0,0 +1,70 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpand(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filter": Schema([]string{"name"}),
+	}, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{
+				"name":   "name",
+				"values": []interface{}{"web*", "db"},
+			},
+		},
+	})
+
+	assert.Equal(t, []Filter{{Name: "name", Values: []string{"web*", "db"}}}, Expand(rd.Get("filter")))
+}
+
+func TestMatch(t *testing.T) {
+	attrs := map[string][]string{
+		"name":  {"web/01"},
+		"state": {"running"},
+		"group": {"fw1", "fw2"},
+	}
+
+	cases := map[string]struct {
+		filters []Filter
+		want    bool
+	}{
+		"no filters": {
+			want: true,
+		},
+		"exact": {
+			filters: []Filter{{Name: "state", Values: []string{"running"}}},
+			want:    true,
+		},
+		"wildcard": {
+			filters: []Filter{{Name: "name", Values: []string{"web*"}}, {Name: "name", Values: []string{"web/0?"}}},
+			want:    true,
+		},
+		"one of values": {
+			filters: []Filter{{Name: "group", Values: []string{"fw9", "fw2"}}},
+			want:    true,
+		},
+		"not matched": {
+			filters: []Filter{{Name: "state", Values: []string{"running"}}, {Name: "name", Values: []string{"db*"}}},
+			want:    false,
+		},
+		"unknown attribute": {
+			filters: []Filter{{Name: "unknown", Values: []string{"*"}}},
+			want:    false,
+		},
+		"regexp meta characters are quoted": {
+			filters: []Filter{{Name: "name", Values: []string{"web.01"}}},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Match(tc.filters, attrs))
+		})
+	}
+}
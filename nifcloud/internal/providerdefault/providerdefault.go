@@ -0,0 +1,58 @@
+package providerdefault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+// DefaultAccountingType is the accounting type used when neither the resource attribute
+// nor the provider-level default_accounting_type is set.
+const DefaultAccountingType = "2"
+
+// isNullInConfig reports whether the attribute is not set in the configuration.
+func isNullInConfig(d *schema.ResourceDiff, key string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+	return raw.GetAttr(key).IsNull()
+}
+
+// AvailabilityZone returns a CustomizeDiffFunc that applies the provider-level default_availability_zone
+// to the availability_zone attribute when the attribute is not set on the new resource.
+// If required is true, it returns an error when neither of them is set.
+func AvailabilityZone(required bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		// availability_zone forces a new resource, so the default is applied only on creation
+		// to keep the plan stable for the existing resources.
+		if d.Id() != "" || !isNullInConfig(d, "availability_zone") {
+			return nil
+		}
+
+		if c, ok := meta.(*client.Client); ok && c.DefaultAvailabilityZone != "" {
+			return d.SetNew("availability_zone", c.DefaultAvailabilityZone)
+		}
+
+		if required {
+			return fmt.Errorf("availability_zone must be set when the provider default_availability_zone is not set")
+		}
+		return nil
+	}
+}
+
+// AccountingType is a CustomizeDiffFunc that applies the provider-level default_accounting_type
+// to the accounting_type attribute when the attribute is not set.
+func AccountingType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !isNullInConfig(d, "accounting_type") {
+		return nil
+	}
+
+	accountingType := DefaultAccountingType
+	if c, ok := meta.(*client.Client); ok && c.DefaultAccountingType != "" {
+		accountingType = c.DefaultAccountingType
+	}
+	return d.SetNew("accounting_type", accountingType)
+}
@@ -0,0 +1,119 @@
+package providerdefault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func testResource(required bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"accounting_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: customdiff.All(
+			AvailabilityZone(required),
+			AccountingType,
+		),
+	}
+}
+
+func diff(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) (*terraform.InstanceDiff, error) {
+	rawConfig := map[string]cty.Value{
+		"availability_zone": cty.NullVal(cty.String),
+		"accounting_type":   cty.NullVal(cty.String),
+	}
+	for k, v := range raw {
+		rawConfig[k] = cty.StringVal(v.(string))
+	}
+
+	if state == nil {
+		state = &terraform.InstanceState{}
+	}
+	state.RawConfig = cty.ObjectVal(rawConfig)
+
+	return r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+}
+
+func TestCustomizeDiff_create(t *testing.T) {
+	meta := &client.Client{DefaultAvailabilityZone: "east-12", DefaultAccountingType: "1"}
+
+	cases := map[string]struct {
+		raw      map[string]interface{}
+		meta     interface{}
+		wantZone string
+		wantType string
+	}{
+		"provider defaults": {
+			raw:      map[string]interface{}{},
+			meta:     meta,
+			wantZone: "east-12",
+			wantType: "1",
+		},
+		"resource attributes take precedence": {
+			raw:      map[string]interface{}{"availability_zone": "east-11", "accounting_type": "2"},
+			meta:     meta,
+			wantZone: "east-11",
+			wantType: "2",
+		},
+		"no provider defaults": {
+			raw:      map[string]interface{}{},
+			meta:     &client.Client{},
+			wantZone: "",
+			wantType: "2",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := diff(t, testResource(false), nil, tc.raw, tc.meta)
+			assert.NoError(t, err)
+
+			if tc.wantZone == "" {
+				assert.True(t, d.Attributes["availability_zone"].NewComputed)
+			} else {
+				assert.Equal(t, tc.wantZone, d.Attributes["availability_zone"].New)
+			}
+			assert.Equal(t, tc.wantType, d.Attributes["accounting_type"].New)
+		})
+	}
+}
+
+func TestCustomizeDiff_required(t *testing.T) {
+	_, err := diff(t, testResource(true), nil, map[string]interface{}{}, &client.Client{})
+	assert.Error(t, err)
+}
+
+func TestCustomizeDiff_existingResource(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":                "test",
+			"availability_zone": "east-11",
+			"accounting_type":   "1",
+		},
+	}
+	meta := &client.Client{DefaultAvailabilityZone: "east-12", DefaultAccountingType: "1"}
+
+	d, err := diff(t, testResource(false), state, map[string]interface{}{}, meta)
+	assert.NoError(t, err)
+
+	// The availability zone is not replaced and the accounting type matches the default.
+	assert.True(t, d == nil || d.Empty())
+}
@@ -0,0 +1,127 @@
+package sharedcredentials
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile name used when no profile is specified.
+const DefaultProfile = "default"
+
+// DefaultFilename returns the default path of the shared credentials file (~/.nifcloud/credentials).
+func DefaultFilename() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".nifcloud", "credentials")
+}
+
+// Credentials is the set of keys read from a profile in the shared credentials file.
+type Credentials struct {
+	AccessKey        string
+	SecretKey        string
+	StorageAccessKey string
+	StorageSecretKey string
+}
+
+// Load reads the profile from the shared credentials files.
+// The files are read in order and the values in the later files take precedence.
+// The files which do not exist are skipped.
+// It returns nil if the profile is not found in any file.
+func Load(filenames []string, profile string) (*Credentials, error) {
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	var creds *Credentials
+	for _, filename := range filenames {
+		sections, err := parseFile(expandHome(filename))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read shared credentials file %s: %s", filename, err)
+		}
+
+		section, ok := sections[profile]
+		if !ok {
+			continue
+		}
+
+		if creds == nil {
+			creds = &Credentials{}
+		}
+		if v, ok := section["access_key"]; ok {
+			creds.AccessKey = v
+		}
+		if v, ok := section["secret_key"]; ok {
+			creds.SecretKey = v
+		}
+		if v, ok := section["storage_access_key"]; ok {
+			creds.StorageAccessKey = v
+		}
+		if v, ok := section["storage_secret_key"]; ok {
+			creds.StorageSecretKey = v
+		}
+	}
+
+	return creds, nil
+}
+
+func expandHome(filename string) string {
+	if filename != "~" && !strings.HasPrefix(filename, "~/") {
+		return filename
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filename
+	}
+	return filepath.Join(home, strings.TrimPrefix(filename, "~"))
+}
+
+// parseFile parses the INI-style file and returns the key/value pairs of each section.
+func parseFile(filename string) (map[string]map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sections := map[string]map[string]string{}
+	current := ""
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(strings.TrimPrefix(line[1:len(line)-1], "profile "))
+			if _, ok := sections[current]; !ok {
+				sections[current] = map[string]string{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || current == "" {
+			return nil, fmt.Errorf("invalid syntax at line %d", n)
+		}
+		sections[current][strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
@@ -0,0 +1,97 @@
+package sharedcredentials
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeFile(t *testing.T, content string) string {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestLoad(t *testing.T) {
+	base := writeFile(t, `
+# comment
+[default]
+access_key = default_access
+secret_key = default_secret
+
+[production]
+access_key         = prod_access
+secret_key         = prod_secret
+storage_access_key = prod_storage_access
+storage_secret_key = prod_storage_secret
+`)
+	override := writeFile(t, `
+[profile production]
+access_key = override_access
+`)
+
+	cases := map[string]struct {
+		filenames []string
+		profile   string
+		want      *Credentials
+	}{
+		"default profile": {
+			filenames: []string{base},
+			want: &Credentials{
+				AccessKey: "default_access",
+				SecretKey: "default_secret",
+			},
+		},
+		"named profile": {
+			filenames: []string{base},
+			profile:   "production",
+			want: &Credentials{
+				AccessKey:        "prod_access",
+				SecretKey:        "prod_secret",
+				StorageAccessKey: "prod_storage_access",
+				StorageSecretKey: "prod_storage_secret",
+			},
+		},
+		"later file takes precedence": {
+			filenames: []string{base, override},
+			profile:   "production",
+			want: &Credentials{
+				AccessKey:        "override_access",
+				SecretKey:        "prod_secret",
+				StorageAccessKey: "prod_storage_access",
+				StorageSecretKey: "prod_storage_secret",
+			},
+		},
+		"missing file is skipped": {
+			filenames: []string{filepath.Join(t.TempDir(), "missing"), base},
+			want: &Credentials{
+				AccessKey: "default_access",
+				SecretKey: "default_secret",
+			},
+		},
+		"profile not found": {
+			filenames: []string{base},
+			profile:   "staging",
+			want:      nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Load(tc.filenames, tc.profile)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLoad_invalidSyntax(t *testing.T) {
+	filename := writeFile(t, "access_key = no_section\n")
+
+	_, err := Load([]string{filename}, "")
+	assert.Error(t, err)
+}
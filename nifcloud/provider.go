@@ -2,8 +2,29 @@ package nifcloud
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/availabilityzones"
+	dselasticip "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/elasticip"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/image"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/images"
+	dsinstance "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instance"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instances"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/instancetypes"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/regions"
+	dssecuritygroup "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/computing/securitygroup"
+	dsdevopsinstance "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/devops/devopsinstance"
+	dszone "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/dns/zone"
+	dsprivatelan "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/network/privatelan"
+	dsrouter "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/network/router"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/rdb/dbengineversions"
+	dsdbinstance "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/rdb/dbinstance"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/rdb/dbinstanceclasses"
+	dssslcertificate "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/sslcertificate/sslcertificate"
+	dsbucket "github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/storage/bucket"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/datasources/storage/objects"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/elasticip"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/imagefrominstance"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/instance"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/keypair"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/resources/computing/multiipaddressgroup"
@@ -93,9 +114,199 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NIFCLOUD_STORAGE_REGION", nil),
 			},
+			"default_availability_zone": {
+				Description: "The availability zone used when the `availability_zone` of a resource is not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"default_accounting_type": {
+				Description:  "The accounting type used when the `accounting_type` of a resource is not set; valid values: `1` (monthly) `2` (pay per use).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
+			},
+			"profile": {
+				Description: "This is the profile name in the shared credentials file. It can also be sourced from the `NIFCLOUD_PROFILE` env var. Defaults to `default`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NIFCLOUD_PROFILE", nil),
+			},
+			"shared_credentials_files": {
+				Description: "This is the list of paths to the shared credentials files. It can also be sourced from the `NIFCLOUD_SHARED_CREDENTIALS_FILE` env var. Defaults to `~/.nifcloud/credentials`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"skip_credentials_validation": {
+				Description: "Skip the validation of the credentials and the region at the provider configure time. The storage credentials are validated before the first storage request unless this is set. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"endpoints": {
+				Description: "The custom endpoint URLs for each service. It overrides the default endpoint resolved from the region.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"computing": {
+							Description:  "The custom endpoint URL for Computing.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"rdb": {
+							Description:  "The custom endpoint URL for RDB.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"nas": {
+							Description:  "The custom endpoint URL for NAS.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"dns": {
+							Description:  "The custom endpoint URL for DNS.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"ess": {
+							Description:  "The custom endpoint URL for ESS.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"storage": {
+							Description:  "The custom endpoint URL for Object Storage Service.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"devops": {
+							Description:  "The custom endpoint URL for DevOps.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"devops_runner": {
+							Description:  "The custom endpoint URL for DevOps Runner.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+			"log_level": {
+				Description:  "The level of the request/response debug logging; valid values: `none`, `headers`, `bodies`. The sensitive values such as passwords, keys and tokens are masked. It can also be sourced from the `NIFCLOUD_LOG_LEVEL` env var. Defaults to `bodies`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NIFCLOUD_LOG_LEVEL", client.LogLevelBodies),
+				ValidateFunc: validation.StringInSlice(client.LogLevels, false),
+			},
+			"max_requests_per_second": {
+				Description:  "The maximum number of API requests per second shared by all resources. `0` means unlimited. Defaults to `0`.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"max_requests_burst": {
+				Description:  "The maximum number of API requests sent at once when `max_requests_per_second` is set. Defaults to `1`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"http": {
+				Description: "The configuration of the HTTP client used for the NIFCLOUD API requests.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"proxy_url": {
+							Description:  "The URL of the HTTP(S) proxy. If not set, the proxy is taken from the `HTTPS_PROXY` and `NO_PROXY` env vars.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"ca_bundle": {
+							Description: "The file path or the PEM encoded content of the additional CA certificates to trust.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"insecure_skip_verify": {
+							Description: "Skip the verification of the server certificate. This should only be used for development.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"request_timeout": {
+							Description:  "The timeout of each HTTP request in seconds. `0` means no timeout. Defaults to `0`.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"retry": {
+				Description: "The retry policy for the NIFCLOUD API requests.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Description:  "The maximum number of attempts for an API request.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      client.DefaultRetryMaxAttempts,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_backoff": {
+							Description:  "The maximum back off delay between attempts in seconds.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      int(client.DefaultRetryMaxBackoff.Seconds()),
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"retryable_error_codes": {
+							Description: "The additional error codes that should be retried.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"nifcloud_image": image.New(),
+			"nifcloud_availability_zones":  availabilityzones.New(),
+			"nifcloud_db_engine_versions":  dbengineversions.New(),
+			"nifcloud_db_instance":         dsdbinstance.New(),
+			"nifcloud_db_instance_classes": dbinstanceclasses.New(),
+			"nifcloud_devops_instance":     dsdevopsinstance.New(),
+			"nifcloud_dns_zone":            dszone.New(),
+			"nifcloud_elastic_ip":          dselasticip.New(),
+			"nifcloud_image":               image.New(),
+			"nifcloud_images":              images.New(),
+			"nifcloud_instance":            dsinstance.New(),
+			"nifcloud_instance_types":      instancetypes.New(),
+			"nifcloud_instances":           instances.New(),
+			"nifcloud_private_lan":         dsprivatelan.New(),
+			"nifcloud_regions":             regions.New(),
+			"nifcloud_router":              dsrouter.New(),
+			"nifcloud_security_group":      dssecuritygroup.New(),
+			"nifcloud_ssl_certificate":     dssslcertificate.New(),
+			"nifcloud_storage_bucket":      dsbucket.New(),
+			"nifcloud_storage_objects":     objects.New(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"nifcloud_customer_gateway":              customergateway.New(),
@@ -112,6 +323,7 @@ func Provider() *schema.Provider {
 			"nifcloud_ess_domain_dkim":               domaindkim.New(),
 			"nifcloud_ess_domain_identity":           domainidentity.New(),
 			"nifcloud_ess_email_identity":            emailidentity.New(),
+			"nifcloud_image_from_instance":           imagefrominstance.New(),
 			"nifcloud_instance":                      instance.New(),
 			"nifcloud_key_pair":                      keypair.New(),
 			"nifcloud_nas_instance":                  nasinstance.New(),
@@ -2,50 +2,183 @@ package nifcloud
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"os"
+	"strings"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/smithy-go/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/sharedcredentials"
 )
 
-type debugLogger struct{}
+// configure implements schema.ConfigureContextFunc
+func configure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	creds, err := resolveCredentials(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 
-func (l debugLogger) Logf(classification logging.Classification, format string, v ...interface{}) {
-	if len(classification) != 0 {
-		format = string(classification) + " " + format
+	httpClient, err := client.NewHTTPClient(expandHTTPOptions(d))
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
-	log.Printf(format, v...)
-}
 
-// configure implements schema.ConfigureContextFunc
-func configure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	cfg := nifcloud.NewConfig(
-		d.Get("access_key").(string),
-		d.Get("secret_key").(string),
+		creds.AccessKey,
+		creds.SecretKey,
 		d.Get("region").(string),
 	)
-	cfg.Retryer = func() aws.Retryer {
-		return aws.NopRetryer{}
-	}
-	cfg.ClientLogMode = aws.LogRequestWithBody | aws.LogResponseWithBody
-	cfg.Logger = &debugLogger{}
+	cfg.HTTPClient = httpClient
+	cfg.Retryer = client.NewRetryer(expandRetryOptions(d))
+	cfg.ClientLogMode = client.LogMode(d.Get("log_level").(string))
+	cfg.Logger = &client.Logger{}
 
 	storageCfg := nifcloud.NewConfig(
-		d.Get("storage_access_key").(string),
-		d.Get("storage_secret_key").(string),
+		creds.StorageAccessKey,
+		creds.StorageSecretKey,
 		d.Get("storage_region").(string),
 	)
-	storageCfg.Retryer = func() aws.Retryer {
-		return aws.NopRetryer{}
+	storageCfg.HTTPClient = httpClient
+	storageCfg.Retryer = client.NewRetryer(expandRetryOptions(d))
+	storageCfg.ClientLogMode = client.LogMode(d.Get("log_level").(string))
+	storageCfg.Logger = &client.Logger{}
+
+	if v := d.Get("max_requests_per_second").(float64); v > 0 {
+		limiter := client.NewRateLimiter(v, d.Get("max_requests_burst").(int))
+		cfg.APIOptions = append(cfg.APIOptions, limiter.AddToStack)
+		storageCfg.APIOptions = append(storageCfg.APIOptions, limiter.AddToStack)
+	}
+
+	skipValidation := d.Get("skip_credentials_validation").(bool)
+	client := client.New(cfg, storageCfg, client.Options{
+		Endpoints:                  expandEndpoints(d),
+		ValidateStorageCredentials: !skipValidation,
+	})
+
+	client.DefaultAvailabilityZone = d.Get("default_availability_zone").(string)
+	client.DefaultAccountingType = d.Get("default_accounting_type").(string)
+
+	if !skipValidation {
+		if err := client.ValidateCredentials(ctx); err != nil {
+			return nil, diag.FromErr(err)
+		}
 	}
-	storageCfg.ClientLogMode = aws.LogRequestWithBody | aws.LogResponseWithBody
-	storageCfg.Logger = &debugLogger{}
 
-	client := client.New(cfg, storageCfg)
 	return client, nil
 }
+
+func expandRetryOptions(d *schema.ResourceData) client.RetryOptions {
+	opts := client.RetryOptions{}
+
+	v, ok := d.GetOk("retry")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return opts
+	}
+
+	retry := v.([]interface{})[0].(map[string]interface{})
+	opts.MaxAttempts = retry["max_attempts"].(int)
+	opts.MaxBackoff = time.Duration(retry["max_backoff"].(int)) * time.Second
+	for _, code := range retry["retryable_error_codes"].(*schema.Set).List() {
+		opts.RetryableErrorCodes = append(opts.RetryableErrorCodes, code.(string))
+	}
+
+	return opts
+}
+
+func expandHTTPOptions(d *schema.ResourceData) client.HTTPOptions {
+	opts := client.HTTPOptions{}
+
+	v, ok := d.GetOk("http")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return opts
+	}
+
+	h := v.([]interface{})[0].(map[string]interface{})
+	opts.ProxyURL = h["proxy_url"].(string)
+	opts.CABundle = h["ca_bundle"].(string)
+	opts.InsecureSkipVerify = h["insecure_skip_verify"].(bool)
+	opts.RequestTimeout = time.Duration(h["request_timeout"].(int)) * time.Second
+
+	return opts
+}
+
+func expandEndpoints(d *schema.ResourceData) client.Endpoints {
+	endpoints := client.Endpoints{}
+
+	v, ok := d.GetOk("endpoints")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return endpoints
+	}
+
+	e := v.([]interface{})[0].(map[string]interface{})
+	endpoints.Computing = e["computing"].(string)
+	endpoints.RDB = e["rdb"].(string)
+	endpoints.NAS = e["nas"].(string)
+	endpoints.DNS = e["dns"].(string)
+	endpoints.ESS = e["ess"].(string)
+	endpoints.Storage = e["storage"].(string)
+	endpoints.DevOps = e["devops"].(string)
+	endpoints.DevOpsRunner = e["devops_runner"].(string)
+
+	return endpoints
+}
+
+// resolveCredentials returns the credentials in the following order of precedence:
+//  1. The provider attributes (access_key, secret_key, storage_access_key, storage_secret_key)
+//  2. The environment variables (NIFCLOUD_ACCESS_KEY_ID, NIFCLOUD_SECRET_ACCESS_KEY, ...)
+//  3. The profile in the shared credentials files
+//
+// The access key and the secret key are resolved as a pair, so that keys from different sources are never mixed.
+func resolveCredentials(d *schema.ResourceData) (*sharedcredentials.Credentials, error) {
+	creds := &sharedcredentials.Credentials{
+		AccessKey:        d.Get("access_key").(string),
+		SecretKey:        d.Get("secret_key").(string),
+		StorageAccessKey: d.Get("storage_access_key").(string),
+		StorageSecretKey: d.Get("storage_secret_key").(string),
+	}
+
+	if creds.AccessKey != "" && creds.SecretKey != "" && creds.StorageAccessKey != "" && creds.StorageSecretKey != "" {
+		return creds, nil
+	}
+
+	filenames := []string{}
+	for _, f := range d.Get("shared_credentials_files").([]interface{}) {
+		filenames = append(filenames, f.(string))
+	}
+	if len(filenames) == 0 {
+		if v := os.Getenv("NIFCLOUD_SHARED_CREDENTIALS_FILE"); v != "" {
+			filenames = append(filenames, v)
+		} else {
+			filenames = append(filenames, sharedcredentials.DefaultFilename())
+		}
+	}
+
+	profile := d.Get("profile").(string)
+	shared, err := sharedcredentials.Load(filenames, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if shared == nil {
+		if profile != "" {
+			return nil, fmt.Errorf("profile %q is not found in the shared credentials files: %s", profile, strings.Join(filenames, ", "))
+		}
+		return creds, nil
+	}
+
+	if creds.AccessKey == "" && creds.SecretKey == "" {
+		creds.AccessKey = shared.AccessKey
+		creds.SecretKey = shared.SecretKey
+	}
+
+	if creds.StorageAccessKey == "" && creds.StorageSecretKey == "" {
+		creds.StorageAccessKey = shared.StorageAccessKey
+		creds.StorageSecretKey = shared.StorageSecretKey
+	}
+
+	return creds, nil
+}
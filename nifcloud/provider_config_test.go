@@ -0,0 +1,120 @@
+package nifcloud
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/sharedcredentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveCredentials(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(filename, []byte(`
+[default]
+access_key         = profile_access
+secret_key         = profile_secret
+storage_access_key = profile_storage_access
+storage_secret_key = profile_storage_secret
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		raw  map[string]interface{}
+		env  map[string]string
+		want *sharedcredentials.Credentials
+	}{
+		"attributes take precedence": {
+			raw: map[string]interface{}{
+				"access_key":               "attr_access",
+				"secret_key":               "attr_secret",
+				"shared_credentials_files": []interface{}{filename},
+			},
+			env: map[string]string{
+				"NIFCLOUD_ACCESS_KEY_ID":     "env_access",
+				"NIFCLOUD_SECRET_ACCESS_KEY": "env_secret",
+			},
+			want: &sharedcredentials.Credentials{
+				AccessKey:        "attr_access",
+				SecretKey:        "attr_secret",
+				StorageAccessKey: "profile_storage_access",
+				StorageSecretKey: "profile_storage_secret",
+			},
+		},
+		"env vars take precedence over profile": {
+			raw: map[string]interface{}{
+				"shared_credentials_files": []interface{}{filename},
+			},
+			env: map[string]string{
+				"NIFCLOUD_ACCESS_KEY_ID":     "env_access",
+				"NIFCLOUD_SECRET_ACCESS_KEY": "env_secret",
+			},
+			want: &sharedcredentials.Credentials{
+				AccessKey:        "env_access",
+				SecretKey:        "env_secret",
+				StorageAccessKey: "profile_storage_access",
+				StorageSecretKey: "profile_storage_secret",
+			},
+		},
+		"profile": {
+			raw: map[string]interface{}{
+				"profile":                  "default",
+				"shared_credentials_files": []interface{}{filename},
+			},
+			want: &sharedcredentials.Credentials{
+				AccessKey:        "profile_access",
+				SecretKey:        "profile_secret",
+				StorageAccessKey: "profile_storage_access",
+				StorageSecretKey: "profile_storage_secret",
+			},
+		},
+		"shared credentials file from env var": {
+			raw: map[string]interface{}{},
+			env: map[string]string{
+				"NIFCLOUD_SHARED_CREDENTIALS_FILE": filename,
+			},
+			want: &sharedcredentials.Credentials{
+				AccessKey:        "profile_access",
+				SecretKey:        "profile_secret",
+				StorageAccessKey: "profile_storage_access",
+				StorageSecretKey: "profile_storage_secret",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range []string{
+				"NIFCLOUD_ACCESS_KEY_ID",
+				"NIFCLOUD_SECRET_ACCESS_KEY",
+				"NIFCLOUD_STORAGE_ACCESS_KEY_ID",
+				"NIFCLOUD_STORAGE_SECRET_ACCESS_KEY",
+				"NIFCLOUD_PROFILE",
+				"NIFCLOUD_SHARED_CREDENTIALS_FILE",
+			} {
+				t.Setenv(k, tc.env[k])
+			}
+
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+			got, err := resolveCredentials(d)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveCredentials_profileNotFound(t *testing.T) {
+	t.Setenv("NIFCLOUD_ACCESS_KEY_ID", "")
+	t.Setenv("NIFCLOUD_SECRET_ACCESS_KEY", "")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"profile":                  "missing",
+		"shared_credentials_files": []interface{}{filepath.Join(t.TempDir(), "credentials")},
+	})
+	_, err := resolveCredentials(d)
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -26,6 +27,7 @@ func New() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(5 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AvailabilityZone(true),
 	}
 }
 
@@ -39,8 +41,9 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone.",
-			Required:    true,
+			Description: "The availability zone. Defaults to the provider `default_availability_zone`.",
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"description": {
@@ -0,0 +1,29 @@
+package imagefrominstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := expandCreateImageOperationInput(d)
+
+	svc := meta.(*client.Client).Computing
+	res, err := svc.CreateImageOperation(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed creating image: %s", err))
+	}
+
+	d.SetId(nifcloud.ToString(res.ImageId))
+
+	if err := waitUntilImageAvailable(ctx, d, svc, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for image to become available: %s", err))
+	}
+
+	return read(ctx, d, meta)
+}
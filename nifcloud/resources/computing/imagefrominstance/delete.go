@@ -0,0 +1,25 @@
+package imagefrominstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if _, err := svc.DeleteImage(ctx, expandDeleteImageInput(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed deleting: %s", err))
+	}
+
+	if err := waitUntilImageDeleted(ctx, d, svc, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for image to be deleted: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
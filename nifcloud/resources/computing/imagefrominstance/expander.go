@@ -0,0 +1,48 @@
+package imagefrominstance
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+)
+
+// expandCreateImageOperationInput always keeps the source instance,
+// because it is usually managed by the nifcloud_instance resource.
+func expandCreateImageOperationInput(d *schema.ResourceData) *computing.CreateImageOperationInput {
+	return &computing.CreateImageOperationInput{
+		InstanceId:   nifcloud.String(d.Get("instance_id").(string)),
+		Name:         nifcloud.String(d.Get("name").(string)),
+		Description:  nifcloud.String(d.Get("description").(string)),
+		NoReboot:     nifcloud.Bool(d.Get("no_reboot").(bool)),
+		LeftInstance: nifcloud.Bool(true),
+	}
+}
+
+func expandDescribeImagesInput(d *schema.ResourceData) *computing.DescribeImagesInput {
+	return &computing.DescribeImagesInput{
+		ImageId: []string{d.Id()},
+	}
+}
+
+func expandModifyImageAttributeInputForName(d *schema.ResourceData) *computing.ModifyImageAttributeInput {
+	return &computing.ModifyImageAttributeInput{
+		ImageId:   nifcloud.String(d.Id()),
+		Attribute: types.AttributeOfModifyImageAttributeRequestImageName,
+		Value:     nifcloud.String(d.Get("name").(string)),
+	}
+}
+
+func expandModifyImageAttributeInputForDescription(d *schema.ResourceData) *computing.ModifyImageAttributeInput {
+	return &computing.ModifyImageAttributeInput{
+		ImageId:   nifcloud.String(d.Id()),
+		Attribute: types.AttributeOfModifyImageAttributeRequestDescription,
+		Value:     nifcloud.String(d.Get("description").(string)),
+	}
+}
+
+func expandDeleteImageInput(d *schema.ResourceData) *computing.DeleteImageInput {
+	return &computing.DeleteImageInput{
+		ImageId: nifcloud.String(d.Id()),
+	}
+}
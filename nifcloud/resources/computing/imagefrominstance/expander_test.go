@@ -0,0 +1,102 @@
+package imagefrominstance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCreateImageOperationInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id": "test_instance_id",
+		"name":        "test_name",
+		"description": "test_description",
+		"no_reboot":   true,
+	})
+
+	tests := []struct {
+		name string
+		args *schema.ResourceData
+		want *computing.CreateImageOperationInput
+	}{
+		{
+			name: "expands the resource data",
+			args: rd,
+			want: &computing.CreateImageOperationInput{
+				InstanceId:   nifcloud.String("test_instance_id"),
+				Name:         nifcloud.String("test_name"),
+				Description:  nifcloud.String("test_description"),
+				NoReboot:     nifcloud.Bool(true),
+				LeftInstance: nifcloud.Bool(true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandCreateImageOperationInput(tt.args)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpandDescribeImagesInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_image_id")
+
+	got := expandDescribeImagesInput(rd)
+	assert.Equal(t, &computing.DescribeImagesInput{
+		ImageId: []string{"test_image_id"},
+	}, got)
+}
+
+func TestExpandModifyImageAttributeInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"name":        "test_name",
+		"description": "test_description",
+	})
+	rd.SetId("test_image_id")
+
+	tests := []struct {
+		name string
+		got  *computing.ModifyImageAttributeInput
+		want *computing.ModifyImageAttributeInput
+	}{
+		{
+			name: "expands the name",
+			got:  expandModifyImageAttributeInputForName(rd),
+			want: &computing.ModifyImageAttributeInput{
+				ImageId:   nifcloud.String("test_image_id"),
+				Attribute: types.AttributeOfModifyImageAttributeRequestImageName,
+				Value:     nifcloud.String("test_name"),
+			},
+		},
+		{
+			name: "expands the description",
+			got:  expandModifyImageAttributeInputForDescription(rd),
+			want: &computing.ModifyImageAttributeInput{
+				ImageId:   nifcloud.String("test_image_id"),
+				Attribute: types.AttributeOfModifyImageAttributeRequestDescription,
+				Value:     nifcloud.String("test_description"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestExpandDeleteImageInput(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rd.SetId("test_image_id")
+
+	got := expandDeleteImageInput(rd)
+	assert.Equal(t, &computing.DeleteImageInput{
+		ImageId: nifcloud.String("test_image_id"),
+	}, got)
+}
@@ -0,0 +1,36 @@
+package imagefrominstance
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+func flatten(d *schema.ResourceData, res *computing.DescribeImagesOutput) error {
+	if res == nil || len(res.ImagesSet) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	image := res.ImagesSet[0]
+
+	if nifcloud.ToString(image.ImageId) != d.Id() {
+		return fmt.Errorf("unable to find image within: %#v", res.ImagesSet)
+	}
+
+	if err := d.Set("image_id", image.ImageId); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", image.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", image.Description); err != nil {
+		return err
+	}
+
+	return nil
+}
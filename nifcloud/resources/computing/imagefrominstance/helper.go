@@ -0,0 +1,77 @@
+package imagefrominstance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
+	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
+)
+
+const imageNotFoundErrorCode = "Client.InvalidParameterNotFound.Image"
+
+// suppressImportedCreationArgumentDiffs suppresses the diffs of the arguments which are only used on creation
+// when the resource is imported, because DescribeImages does not return them.
+func suppressImportedCreationArgumentDiffs(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return oldValue == "" && d.Id() != ""
+}
+
+// waitUntilImageAvailable waits until the state of the image become available.
+// Computing SDK does not provide a waiter for images.
+func waitUntilImageAvailable(ctx context.Context, d *schema.ResourceData, svc *computing.Client, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		res, err := svc.DescribeImages(ctx, expandDescribeImagesInput(d))
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == imageNotFoundErrorCode {
+				return retry.RetryableError(fmt.Errorf("expected the image was created"))
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		if len(res.ImagesSet) == 0 {
+			return retry.RetryableError(fmt.Errorf("expected the image was created"))
+		}
+
+		switch state := nifcloud.ToString(res.ImagesSet[0].ImageState); state {
+		case "available":
+			return nil
+		case "", "pending":
+			return retry.RetryableError(fmt.Errorf("expected the image was in state available"))
+		case "failed":
+			return retry.NonRetryableError(fmt.Errorf("failed creating the image: the image is in state failed"))
+		default:
+			return retry.NonRetryableError(fmt.Errorf("unexpected state of the image while waiting for it to become available: %s", state))
+		}
+	})
+
+	return err
+}
+
+// waitUntilImageDeleted waits until the image is deleted.
+// Computing SDK does not provide a waiter for images.
+func waitUntilImageDeleted(ctx context.Context, d *schema.ResourceData, svc *computing.Client, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		res, err := svc.DescribeImages(ctx, expandDescribeImagesInput(d))
+		if err != nil {
+			var awsErr smithy.APIError
+			if errors.As(err, &awsErr) && awsErr.ErrorCode() == imageNotFoundErrorCode {
+				return nil
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		if len(res.ImagesSet) == 0 {
+			return nil
+		}
+
+		return retry.RetryableError(fmt.Errorf("expected the image was deleted"))
+	})
+
+	return err
+}
@@ -0,0 +1,50 @@
+package imagefrominstance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressImportedCreationArgumentDiffs(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		oldValue string
+		newValue string
+		want     bool
+	}{
+		{
+			name:     "does not suppress the diff on creation",
+			id:       "",
+			oldValue: "",
+			newValue: "web001",
+			want:     false,
+		},
+		{
+			name:     "suppresses the diff of the imported resource",
+			id:       "test_image_id",
+			oldValue: "",
+			newValue: "web001",
+			want:     true,
+		},
+		{
+			name:     "does not suppress the diff of the created resource",
+			id:       "test_image_id",
+			oldValue: "web001",
+			newValue: "web002",
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+			rd.SetId(tt.id)
+
+			got := suppressImportedCreationArgumentDiffs("instance_id", tt.oldValue, tt.newValue, rd)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
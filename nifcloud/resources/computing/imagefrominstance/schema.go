@@ -0,0 +1,67 @@
+package imagefrominstance
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const description = "Provides a private image resource created from an existing instance."
+
+// New returns the nifcloud_image_from_instance resource schema.
+func New() *schema.Resource {
+	return &schema.Resource{
+		Description: description,
+		Schema:      newSchema(),
+
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+			Create:  schema.DefaultTimeout(60 * time.Minute),
+			Delete:  schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:             schema.TypeString,
+			Description:      "The instance name to create the image from.",
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressImportedCreationArgumentDiffs,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the image.",
+			Required:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The image description.",
+			Optional:    true,
+		},
+		"no_reboot": {
+			Type:             schema.TypeBool,
+			Description:      "Create the image without stopping the instance, so that the instance keeps running.",
+			Optional:         true,
+			Default:          false,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressImportedCreationArgumentDiffs,
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the image.",
+			Computed:    true,
+		},
+	}
+}
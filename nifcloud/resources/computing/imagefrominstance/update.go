@@ -0,0 +1,32 @@
+package imagefrominstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/client"
+)
+
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	svc := meta.(*client.Client).Computing
+
+	if d.HasChange("name") {
+		input := expandModifyImageAttributeInputForName(d)
+
+		if _, err := svc.ModifyImageAttribute(ctx, input); err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating image name: %s", err))
+		}
+	}
+
+	if d.HasChange("description") {
+		input := expandModifyImageAttributeInputForDescription(d)
+
+		if _, err := svc.ModifyImageAttribute(ctx, input); err != nil {
+			return diag.FromErr(fmt.Errorf("failed updating image description: %s", err))
+		}
+	}
+
+	return read(ctx, d, meta)
+}
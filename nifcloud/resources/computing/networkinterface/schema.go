@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -27,6 +28,7 @@ func New() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(5 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AvailabilityZone(false),
 	}
 }
 
@@ -34,7 +36,7 @@ func newSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone.",
+			Description: "The availability zone. Defaults to the provider `default_availability_zone`.",
 			Optional:    true,
 			Computed:    true,
 			ForceNew:    true,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -29,6 +30,7 @@ func New() *schema.Resource {
 			Default: schema.DefaultTimeout(5 * time.Minute),
 			Delete:  schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AvailabilityZone(true),
 	}
 }
 
@@ -51,8 +53,9 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone.",
-			Required:    true,
+			Description: "The availability zone. Defaults to the provider `default_availability_zone`.",
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"log_limit": {
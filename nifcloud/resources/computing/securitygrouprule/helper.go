@@ -2,12 +2,15 @@ package securitygrouprule
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nifcloud/nifcloud-sdk-go/nifcloud"
 	"github.com/nifcloud/nifcloud-sdk-go/service/computing"
@@ -31,12 +34,40 @@ func (err securityGroupNotFound) Error() string {
 }
 
 func checkSecurityGroupExist(securityGroupInfo []types.SecurityGroupInfo, groupName string) error {
-	for _, s := range securityGroupInfo {
-		if nifcloud.ToString(s.GroupName) == groupName {
-			return nil
+	if findSecurityGroup(securityGroupInfo, groupName) == nil {
+		return securityGroupNotFound{name: groupName, securityGroups: securityGroupInfo}
+	}
+	return nil
+}
+
+func findSecurityGroup(securityGroupInfo []types.SecurityGroupInfo, groupName string) *types.SecurityGroupInfo {
+	for i := range securityGroupInfo {
+		if nifcloud.ToString(securityGroupInfo[i].GroupName) == groupName {
+			return &securityGroupInfo[i]
 		}
 	}
-	return securityGroupNotFound{name: groupName, securityGroups: securityGroupInfo}
+	return nil
+}
+
+// FindSecurityGroup describes the security group with the groupName.
+// It returns an error if the security group does not exist.
+func FindSecurityGroup(ctx context.Context, svc *computing.Client, groupName string) (*types.SecurityGroupInfo, error) {
+	res, err := svc.DescribeSecurityGroups(ctx, &computing.DescribeSecurityGroupsInput{
+		GroupName: []string{groupName},
+	})
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "Client.InvalidParameterNotFound.SecurityGroup" {
+			return nil, securityGroupNotFound{name: groupName}
+		}
+		return nil, err
+	}
+
+	securityGroup := findSecurityGroup(res.SecurityGroupInfo, groupName)
+	if securityGroup == nil {
+		return nil, securityGroupNotFound{name: groupName, securityGroups: res.SecurityGroupInfo}
+	}
+	return securityGroup, nil
 }
 
 func idHash(inputList []*computing.AuthorizeSecurityGroupIngressInput) string {
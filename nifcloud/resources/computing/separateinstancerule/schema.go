@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -28,6 +29,7 @@ func New() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(5 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AvailabilityZone(true),
 	}
 }
 
@@ -64,8 +66,9 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone.",
-			Required:    true,
+			Description: "The availability zone. Defaults to the provider `default_availability_zone`.",
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"description": {
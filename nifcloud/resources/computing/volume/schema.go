@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -29,6 +30,7 @@ func New() *schema.Resource {
 			Default: schema.DefaultTimeout(5 * time.Minute),
 			Update:  schema.DefaultTimeout(20 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AccountingType,
 	}
 }
 
@@ -103,9 +105,9 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"accounting_type": {
 			Type:         schema.TypeString,
-			Description:  "Accounting type. (1: monthly, 2: pay per use).",
+			Description:  "Accounting type. (1: monthly, 2: pay per use). Defaults to the provider `default_accounting_type`, or `2` if it is not set.",
 			Optional:     true,
-			Default:      "2",
+			Computed:     true,
 			ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
 		},
 		"description": {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 	"golang.org/x/exp/slices"
 )
@@ -32,6 +33,7 @@ func New() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(5 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AvailabilityZone(false),
 	}
 }
 
@@ -48,7 +50,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone for the DevOps firewall group.",
+			Description: "The availability zone for the DevOps firewall group. Defaults to the provider `default_availability_zone`.",
 			Optional:    true,
 			Computed:    true,
 			ForceNew:    true,
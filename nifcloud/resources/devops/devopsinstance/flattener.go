@@ -8,6 +8,12 @@ import (
 	"github.com/nifcloud/nifcloud-sdk-go/service/devops"
 )
 
+// Flatten sets the attributes of the DevOps instance to d.
+// The ID of d must be the instance ID. It is shared with the nifcloud_devops_instance data source.
+func Flatten(d *schema.ResourceData, res *devops.GetInstanceOutput) error {
+	return flatten(d, res)
+}
+
 func flatten(d *schema.ResourceData, res *devops.GetInstanceOutput) error {
 	if res == nil || res.Instance == nil {
 		d.SetId("")
@@ -48,33 +54,37 @@ func flatten(d *schema.ResourceData, res *devops.GetInstanceOutput) error {
 		return err
 	}
 
-	if err := d.Set("network_id", instance.NetworkConfig.NetworkId); err != nil {
-		return err
-	}
-
-	if err := d.Set("private_address", instance.NetworkConfig.PrivateAddress); err != nil {
-		return err
-	}
-
-	if err := d.Set("object_storage_account", instance.ObjectStorageConfig.Account); err != nil {
-		return err
-	}
+	if instance.NetworkConfig != nil {
+		if err := d.Set("network_id", instance.NetworkConfig.NetworkId); err != nil {
+			return err
+		}
 
-	if err := d.Set("object_storage_region", instance.ObjectStorageConfig.Region); err != nil {
-		return err
+		if err := d.Set("private_address", instance.NetworkConfig.PrivateAddress); err != nil {
+			return err
+		}
 	}
 
-	if instance.ObjectStorageConfig.BucketUseObjects != nil {
-		if err := d.Set("lfs_bucket_name", instance.ObjectStorageConfig.BucketUseObjects.Lfs); err != nil {
+	if instance.ObjectStorageConfig != nil {
+		if err := d.Set("object_storage_account", instance.ObjectStorageConfig.Account); err != nil {
 			return err
 		}
 
-		if err := d.Set("packages_bucket_name", instance.ObjectStorageConfig.BucketUseObjects.Packages); err != nil {
+		if err := d.Set("object_storage_region", instance.ObjectStorageConfig.Region); err != nil {
 			return err
 		}
 
-		if err := d.Set("container_registry_bucket_name", instance.ObjectStorageConfig.BucketUseObjects.ContainerRegistry); err != nil {
-			return err
+		if instance.ObjectStorageConfig.BucketUseObjects != nil {
+			if err := d.Set("lfs_bucket_name", instance.ObjectStorageConfig.BucketUseObjects.Lfs); err != nil {
+				return err
+			}
+
+			if err := d.Set("packages_bucket_name", instance.ObjectStorageConfig.BucketUseObjects.Packages); err != nil {
+				return err
+			}
+
+			if err := d.Set("container_registry_bucket_name", instance.ObjectStorageConfig.BucketUseObjects.ContainerRegistry); err != nil {
+				return err
+			}
 		}
 	}
 
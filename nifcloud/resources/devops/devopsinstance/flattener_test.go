@@ -38,6 +38,24 @@ func TestFlatten(t *testing.T) {
 
 	wantNotFoundRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
 
+	rdWithoutConfigs := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{})
+	rdWithoutConfigs.SetId("test_id")
+
+	wantWithoutConfigsRd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
+		"instance_id":          "test_id",
+		"instance_type":        "c-large",
+		"firewall_group_name":  "test_name_fg",
+		"parameter_group_name": "test_name_pg",
+		"disk_size":            100,
+		"availability_zone":    "east-11",
+		"description":          "test_description",
+		"gitlab_url":           "test_url_gl",
+		"registry_url":         "test_url_cr",
+		"public_ip_address":    "198.51.100.1",
+		"to":                   "test@mail.com",
+	})
+	wantWithoutConfigsRd.SetId("test_id")
+
 	type args struct {
 		res *devops.GetInstanceOutput
 		d   *schema.ResourceData
@@ -82,6 +100,30 @@ func TestFlatten(t *testing.T) {
 			},
 			want: wantRd,
 		},
+		{
+			name: "flattens the response without the network and object storage configs",
+			args: args{
+				d: rdWithoutConfigs,
+				res: &devops.GetInstanceOutput{
+					Instance: &types.Instance{
+						InstanceId:          nifcloud.String("test_id"),
+						InstanceType:        nifcloud.String("c-large"),
+						FirewallGroupName:   nifcloud.String("test_name_fg"),
+						ParameterGroupName:  nifcloud.String("test_name_pg"),
+						DiskSize:            nifcloud.Int32(int32(100)),
+						AvailabilityZone:    nifcloud.String("east-11"),
+						Description:         nifcloud.String("test_description"),
+						NetworkConfig:       nil,
+						ObjectStorageConfig: nil,
+						To:                  nifcloud.String("test@mail.com"),
+						GitlabUrl:           nifcloud.String("test_url_gl"),
+						RegistryUrl:         nifcloud.String("test_url_cr"),
+						PublicIpAddress:     nifcloud.String("198.51.100.1"),
+					},
+				},
+			},
+			want: wantWithoutConfigsRd,
+		},
 		{
 			name: "flattens the response even when the resource has been removed externally",
 			args: args{
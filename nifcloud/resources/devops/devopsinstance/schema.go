@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -34,14 +35,17 @@ func New() *schema.Resource {
 			Update:  schema.DefaultTimeout(80 * time.Minute),
 			Delete:  schema.DefaultTimeout(30 * time.Minute),
 		},
-		CustomizeDiff: customdiff.ValidateChange(
-			"disk_size",
-			func(ctx context.Context, o, n, meta interface{}) error {
-				if n.(int) < o.(int) {
-					return fmt.Errorf("new disk size value must be greater than or equal to old value %d", o.(int))
-				}
-				return nil
-			},
+		CustomizeDiff: customdiff.All(
+			customdiff.ValidateChange(
+				"disk_size",
+				func(ctx context.Context, o, n, meta interface{}) error {
+					if n.(int) < o.(int) {
+						return fmt.Errorf("new disk size value must be greater than or equal to old value %d", o.(int))
+					}
+					return nil
+				},
+			),
+			providerdefault.AvailabilityZone(false),
 		),
 	}
 }
@@ -92,7 +96,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone for the DevOps instance.",
+			Description: "The availability zone for the DevOps instance. Defaults to the provider `default_availability_zone`.",
 			Optional:    true,
 			Computed:    true,
 			ForceNew:    true,
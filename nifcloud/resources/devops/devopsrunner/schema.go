@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -31,6 +32,7 @@ func New() *schema.Resource {
 			Update:  schema.DefaultTimeout(80 * time.Minute),
 			Delete:  schema.DefaultTimeout(30 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AvailabilityZone(false),
 	}
 }
 
@@ -52,7 +54,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone for the DevOps Runner.",
+			Description: "The availability zone for the DevOps Runner. Defaults to the provider `default_availability_zone`.",
 			Optional:    true,
 			Computed:    true,
 			ForceNew:    true,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -30,6 +31,7 @@ func New() *schema.Resource {
 			Update:  schema.DefaultTimeout(60 * time.Minute),
 			Delete:  schema.DefaultTimeout(20 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AvailabilityZone(false),
 	}
 }
 
@@ -42,7 +44,7 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The AZ for the NAS instance.",
+			Description: "The AZ for the NAS instance. Defaults to the provider `default_availability_zone`.",
 			Optional:    true,
 			Computed:    true,
 			ForceNew:    true,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 )
 
 const description = "Provide a load_balancer resource"
@@ -37,6 +38,7 @@ func New() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(5 * time.Minute),
 		},
+		CustomizeDiff: providerdefault.AccountingType,
 	}
 }
 
@@ -53,9 +55,9 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"accounting_type": {
 			Type:         schema.TypeString,
-			Description:  "Accounting type. (1: monthly, 2: pay per use).",
+			Description:  "Accounting type. (1: monthly, 2: pay per use). Defaults to the provider `default_accounting_type`, or `2` if it is not set.",
 			Optional:     true,
-			Default:      "2",
+			Computed:     true,
 			ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
 		},
 		"dns_name": {
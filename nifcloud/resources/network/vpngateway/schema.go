@@ -4,8 +4,10 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/validator"
 )
 
@@ -31,6 +33,10 @@ func New() *schema.Resource {
 			Update:  schema.DefaultTimeout(20 * time.Minute),
 			Delete:  schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: customdiff.All(
+			providerdefault.AvailabilityZone(false),
+			providerdefault.AccountingType,
+		),
 	}
 }
 
@@ -65,15 +71,16 @@ func newSchema() map[string]*schema.Schema {
 		},
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The availability zone.",
+			Description: "The availability zone. Defaults to the provider `default_availability_zone`.",
 			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"accounting_type": {
 			Type:         schema.TypeString,
-			Description:  "The accounting type.",
+			Description:  "The accounting type. Defaults to the provider `default_accounting_type`, or `2` if it is not set.",
 			Optional:     true,
-			Default:      "2",
+			Computed:     true,
 			ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
 		},
 		"network_id": {
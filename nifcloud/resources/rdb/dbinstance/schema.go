@@ -4,8 +4,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nifcloud/terraform-provider-nifcloud/nifcloud/internal/providerdefault"
 )
 
 const description = "Provides a rdb instance resource."
@@ -30,6 +32,10 @@ func New() *schema.Resource {
 			Update:  schema.DefaultTimeout(120 * time.Minute),
 			Delete:  schema.DefaultTimeout(60 * time.Minute),
 		},
+		CustomizeDiff: customdiff.All(
+			providerdefault.AvailabilityZone(false),
+			providerdefault.AccountingType,
+		),
 	}
 }
 
@@ -37,9 +43,9 @@ func newSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"accounting_type": {
 			Type:         schema.TypeString,
-			Description:  "Accounting type. (1: monthly, 2: pay per use).",
+			Description:  "Accounting type. (1: monthly, 2: pay per use). Defaults to the provider `default_accounting_type`, or `2` if it is not set.",
 			Optional:     true,
-			Default:      "2",
+			Computed:     true,
 			ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
 		},
 		"instance_class": {
@@ -112,7 +118,7 @@ func newSchema() map[string]*schema.Schema {
 
 		"availability_zone": {
 			Type:        schema.TypeString,
-			Description: "The AZ for the DB instance.",
+			Description: "The AZ for the DB instance. Defaults to the provider `default_availability_zone`.",
 			Optional:    true,
 			Computed:    true,
 			ForceNew:    true,
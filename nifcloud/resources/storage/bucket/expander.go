@@ -7,16 +7,18 @@ import (
 	"github.com/nifcloud/nifcloud-sdk-go/service/storage/types"
 )
 
-func expandGetBucketVersioningInput(d *schema.ResourceData) *storage.GetBucketVersioningInput {
+// ExpandGetBucketVersioningInput returns the input of GetBucketVersioning for the bucket.
+func ExpandGetBucketVersioningInput(bucket string) *storage.GetBucketVersioningInput {
 	input := &storage.GetBucketVersioningInput{
-		Bucket: nifcloud.String(d.Id()),
+		Bucket: nifcloud.String(bucket),
 	}
 	return input
 }
 
-func expandGetBucketPolicyInput(d *schema.ResourceData) *storage.GetBucketPolicyInput {
+// ExpandGetBucketPolicyInput returns the input of GetBucketPolicy for the bucket.
+func ExpandGetBucketPolicyInput(bucket string) *storage.GetBucketPolicyInput {
 	input := &storage.GetBucketPolicyInput{
-		Bucket: nifcloud.String(d.Id()),
+		Bucket: nifcloud.String(bucket),
 	}
 	return input
 }
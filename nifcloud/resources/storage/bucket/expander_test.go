@@ -11,19 +11,14 @@ import (
 )
 
 func TestExpandGetBucketVersioningInput(t *testing.T) {
-	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
-		"bucket": "test_bucket",
-	})
-	rd.SetId("test_bucket")
-
 	tests := []struct {
 		name string
-		args *schema.ResourceData
+		args string
 		want *storage.GetBucketVersioningInput
 	}{
 		{
-			name: "expands the resource data",
-			args: rd,
+			name: "expands the bucket name",
+			args: "test_bucket",
 			want: &storage.GetBucketVersioningInput{
 				Bucket: nifcloud.String("test_bucket"),
 			},
@@ -32,26 +27,21 @@ func TestExpandGetBucketVersioningInput(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := expandGetBucketVersioningInput(tt.args)
+			got := ExpandGetBucketVersioningInput(tt.args)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
 func TestExpandGetBucketPolicyInput(t *testing.T) {
-	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]interface{}{
-		"bucket": "test_bucket",
-	})
-	rd.SetId("test_bucket")
-
 	tests := []struct {
 		name string
-		args *schema.ResourceData
+		args string
 		want *storage.GetBucketPolicyInput
 	}{
 		{
-			name: "expands the resource data",
-			args: rd,
+			name: "expands the bucket name",
+			args: "test_bucket",
 			want: &storage.GetBucketPolicyInput{
 				Bucket: nifcloud.String("test_bucket"),
 			},
@@ -60,7 +50,7 @@ func TestExpandGetBucketPolicyInput(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := expandGetBucketPolicyInput(tt.args)
+			got := ExpandGetBucketPolicyInput(tt.args)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -13,7 +13,7 @@ func flatten(d *schema.ResourceData, bucket types.Buckets,
 		return err
 	}
 
-	if err := d.Set("versioning", flattenVersioning(versioningRes)); err != nil {
+	if err := d.Set("versioning", FlattenVersioning(versioningRes)); err != nil {
 		return err
 	}
 
@@ -26,7 +26,8 @@ func flatten(d *schema.ResourceData, bucket types.Buckets,
 	return nil
 }
 
-func flattenVersioning(out *storage.GetBucketVersioningOutput) []map[string]interface{} {
+// FlattenVersioning returns the versioning block from the output of GetBucketVersioning.
+func FlattenVersioning(out *storage.GetBucketVersioningOutput) []map[string]interface{} {
 	res := map[string]interface{}{}
 
 	if out != nil && nifcloud.ToString(out.Status) == "Enabled" {
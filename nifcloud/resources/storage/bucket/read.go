@@ -20,19 +20,19 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 		return diag.FromErr(fmt.Errorf("failed reading buckets: %s", err))
 	}
 
-	bucket, found := findBucket(res.Buckets, d.Id())
+	bucket, found := FindBucket(res.Buckets, d.Id())
 	if !found {
 		d.SetId("")
 		return nil
 	}
 
-	getBucketVersioningInput := expandGetBucketVersioningInput(d)
+	getBucketVersioningInput := ExpandGetBucketVersioningInput(d.Id())
 	versioningRes, err := svc.GetBucketVersioning(ctx, getBucketVersioningInput)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed reading bucket versioning: %s", err))
 	}
 
-	getBucketPolicyInput := expandGetBucketPolicyInput(d)
+	getBucketPolicyInput := ExpandGetBucketPolicyInput(d.Id())
 	policyRes, err := svc.GetBucketPolicy(ctx, getBucketPolicyInput)
 	if err != nil {
 		var awsErr smithy.APIError
@@ -50,7 +50,8 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Di
 	return nil
 }
 
-func findBucket(buckets []types.Buckets, name string) (types.Buckets, bool) {
+// FindBucket returns the bucket which has the name from the output of GetService.
+func FindBucket(buckets []types.Buckets, name string) (types.Buckets, bool) {
 	for _, bucket := range buckets {
 		if nifcloud.ToString(bucket.Name) == name {
 			return bucket, true